@@ -1,21 +1,23 @@
 package goonvif
 
 import (
+	"context"
 	"encoding/xml"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
-	"reflect"
 	"strconv"
 	"strings"
 
 	"github.com/beevik/etree"
-	"github.com/use-go/goonvif/device"
 	"github.com/use-go/goonvif/gosoap"
 	"github.com/use-go/goonvif/networking"
 )
 
+//go:generate go run ./cmd/clientgen -types Device/types.go -package goonvif -import github.com/use-go/goonvif/device -client DeviceClient -out device-client.go
+
 //Xlmns XML Scheam
 var Xlmns = map[string]string{
 	"xsi":          "http://www.w3.org/2001/XMLSchema-instance",
@@ -41,6 +43,7 @@ var Xlmns = map[string]string{
 	"tmd":          "http://www.onvif.org/ver10/deviceIO/wsdl",
 	"tptz":         "http://www.onvif.org/ver20/ptz/wsdl",
 	"trt":          "http://www.onvif.org/ver10/media/wsdl",
+	"tr2":          "http://www.onvif.org/ver20/media/wsdl",
 	"tns1":         "http://www.onvif.org/ver10/topics",
 	"timg":         "http://www.onvif.org/ver20/imaging/wsdl",
 	"tan":          "http://www.onvif.org/ver20/analytics/wsdl",
@@ -95,64 +98,47 @@ func readResponse(resp *http.Response) string {
 	return string(b)
 }
 
-func (dev *Device) getSupportedServices(resp *http.Response) {
-
-	doc := etree.NewDocument()
-	data, _ := ioutil.ReadAll(resp.Body)
-	if err := doc.ReadFromBytes(data); err != nil {
-		return
-	}
-	services := doc.FindElements("./Envelope/Body/GetCapabilitiesResponse/Capabilities/*/XAddr")
-	for _, j := range services {
-		dev.addEndpoint(j.Parent().Tag, j.Text())
+//newDevice applies options and registers the device management endpoint,
+//the other endpoints are discovered from the device
+func newDevice(xaddr string, options []DeviceOption) *Device {
+	dev := new(Device)
+	dev.xaddr = xaddr
+	dev.scheme = "http"
+	for _, option := range options {
+		option(dev)
 	}
+
+	dev.endpoints = make(map[string]string)
+	dev.services = make(map[string]ServiceInfo)
+	dev.addEndpoint("Device", dev.scheme+"://"+xaddr+"/onvif/device_service")
+	return dev
 }
 
 //NewDevice function construct a ONVIF Device entity
-func NewDevice(xaddr string) (*Device, error) {
-	dev := new(Device)
-	dev.xaddr = xaddr
-	dev.endpoints = make(map[string]string)
-	dev.addEndpoint("Device", "http://"+xaddr+"/onvif/device_service")
-	dev.addEndpoint("Search", "http://"+xaddr+"/onvif/Search_service")
-	dev.addEndpoint("Recording", "http://"+xaddr+"/onvif/recording_service")
-	dev.addEndpoint("Replay", "http://"+xaddr+"/onvif/replay_service")
+func NewDevice(xaddr string, options ...DeviceOption) (*Device, error) {
+	dev := newDevice(xaddr, options)
 
-	getCapabilities := device.GetCapabilities{Category: "All"}
+	//best effort, devices not answering GetSystemDateAndTime keep the local clock
+	dev.SyncClock(context.Background())
 
-	resp, err := dev.CallMethod(getCapabilities, nil)
-	// fmt.Println(resp.Request.Host)
-	// fmt.Println(readResponse(resp))
-	if err != nil || resp.StatusCode != http.StatusOK {
+	if err := dev.discoverServices(context.Background()); err != nil {
 		return nil, errors.New("camera is not available at " + xaddr + " or it does not support ONVIF services")
 	}
-
-	dev.getSupportedServices(resp)
 	return dev, nil
 }
 
 //NewDeviceWithAuth function construct a ONVIF Device entity with username and password
-func NewDeviceWithAuth(xaddr, username, password string) (*Device, error) {
-	dev := new(Device)
-	dev.xaddr = xaddr
-	dev.endpoints = make(map[string]string)
-	dev.addEndpoint("Device", "http://"+xaddr+"/onvif/device_service")
-	dev.addEndpoint("Search", "http://"+xaddr+"/onvif/Search_service")
-	dev.addEndpoint("Recording", "http://"+xaddr+"/onvif/recording_service")
-	dev.addEndpoint("Replay", "http://"+xaddr+"/onvif/replay_service")
+func NewDeviceWithAuth(xaddr, username, password string, options ...DeviceOption) (*Device, error) {
+	dev := newDevice(xaddr, options)
 
 	dev.Authenticate(username, password)
 
-	getCapabilities := device.GetCapabilities{Category: "All"}
+	//best effort, devices not answering GetSystemDateAndTime keep the local clock
+	dev.SyncClock(context.Background())
 
-	resp, err := dev.CallMethod(getCapabilities, nil)
-	// fmt.Println(resp.Request.Host)
-	// fmt.Println(readResponse(resp))
-	if err != nil || resp.StatusCode != http.StatusOK {
-		return nil, errors.New(fmt.Sprintln("GetCapabilitier failed at:", xaddr, err))
+	if err := dev.discoverServices(context.Background()); err != nil {
+		return nil, errors.New(fmt.Sprintln("GetServices and GetCapabilities failed at:", xaddr, err))
 	}
-
-	dev.getSupportedServices(resp)
 	return dev, nil
 }
 
@@ -171,6 +157,23 @@ func (dev *Device) addEndpoint(Key, Value string) {
 func (dev *Device) Authenticate(username, password string) {
 	dev.login = username
 	dev.password = password
+	dev.digest = networking.NewDigestAuth(username, password)
+}
+
+//SetAuthMode chooses WS-Security, HTTP Digest or both for the following requests
+func (dev *Device) SetAuthMode(mode AuthMode) {
+	dev.authMode = mode
+}
+
+//DeviceClient typed client of the ONVIF Device management service,
+//its methods are generated from the device package by cmd/clientgen
+type DeviceClient struct {
+	dev *Device
+}
+
+//Device returns the typed Device management client
+func (dev *Device) Device() *DeviceClient {
+	return &DeviceClient{dev: dev}
 }
 
 //GetEndpoint returns specific ONVIF service endpoint address
@@ -217,18 +220,106 @@ func (dev *Device) getEndpoint(endpoint string) (string, error) {
 //CallMethod functions call an method, defined <method> struct.
 //You should use Authenticate method to call authorized requests.
 func (dev *Device) CallMethod(method interface{}, headerFileds map[string]string) (*http.Response, error) {
-	pkgPath := strings.Split(reflect.TypeOf(method).PkgPath(), "/")
-	pkg := strings.ToLower(pkgPath[len(pkgPath)-1])
+	return dev.CallMethodContext(context.Background(), method, headerFileds)
+}
 
-	endpoint, err := dev.getEndpoint(pkg)
+//CallMethodContext works like CallMethod, the request is canceled when <ctx> is done
+func (dev *Device) CallMethodContext(ctx context.Context, method interface{}, headerFileds map[string]string) (*http.Response, error) {
+	endpoint, err := dev.getMethodEndpoint(method)
 	if err != nil {
 		return nil, err
 	}
-	return dev.callMethodDo(endpoint, method, headerFileds)
+	return dev.callMethodDo(ctx, endpoint, method, headerFileds, true)
+}
+
+//CallMethodUnmarshal calls <method> and unmarshal the matching <method>Response
+//element of the soap body into <response>
+//A ter:NotAuthorized fault re-syncs the device clock and retries the call once,
+//digests built from a drifting clock are a common cause of it
+func (dev *Device) CallMethodUnmarshal(ctx context.Context, method interface{}, response interface{}) error {
+	return dev.CallMethodUnmarshalTo(ctx, "", method, nil, response)
+}
+
+//CallMethodTo works like CallMethodContext but posts <method> to <endpoint>,
+//e.g. the address of a subscription created by the device
+func (dev *Device) CallMethodTo(ctx context.Context, endpoint string, method interface{}, headerFileds map[string]string) (*http.Response, error) {
+	return dev.callMethodDo(ctx, endpoint, method, headerFileds, true)
+}
+
+//CallMethodUnmarshalTo works like CallMethodUnmarshal but posts <method> to <endpoint>
+//with the <headerFileds> (e.g. to, action), an empty endpoint chooses the service of <method>
+func (dev *Device) CallMethodUnmarshalTo(ctx context.Context, endpoint string, method interface{}, headerFileds map[string]string, response interface{}) error {
+	err := dev.callMethodUnmarshal(ctx, endpoint, method, headerFileds, response)
+	if errors.Is(err, gosoap.ErrNotAuthorized) && dev.login != "" {
+		if syncErr := dev.SyncClock(ctx); syncErr == nil {
+			err = dev.callMethodUnmarshal(ctx, endpoint, method, headerFileds, response)
+		}
+	}
+	return err
+}
+
+func (dev *Device) callMethodUnmarshal(ctx context.Context, endpoint string, method interface{}, headerFileds map[string]string, response interface{}) error {
+	var resp *http.Response
+	var err error
+	if endpoint == "" {
+		resp, err = dev.CallMethodContext(ctx, method, headerFileds)
+	} else {
+		resp, err = dev.CallMethodTo(ctx, endpoint, method, headerFileds)
+	}
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return unmarshalResponse(resp, responseTag(method), response)
 }
 
-//CallMethod functions call an method, defined <method> struct with authentication data
-func (dev *Device) callMethodDo(endpoint string, method interface{}, headerFileds map[string]string) (*http.Response, error) {
+//responseTag returns the local name of the response element of <method>,
+//taken from the XMLName tag (e.g. tds:GetScopes -> GetScopesResponse) or the type name
+func responseTag(method interface{}) string {
+	name := xmlNameTag(method)
+	if i := strings.Index(name, ":"); i >= 0 {
+		name = name[i+1:]
+	}
+	return name + "Response"
+}
+
+//unmarshalResponse decodes the <tag> element in soap body of resp into v,
+//a soap fault is returned as *gosoap.Fault
+func unmarshalResponse(resp *http.Response, tag string, v interface{}) error {
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(data); err != nil {
+		return fmt.Errorf("%s: %v", resp.Status, err)
+	}
+	body := doc.FindElement("./Envelope/Body")
+	if body == nil {
+		return errors.New("bad response body")
+	}
+
+	if fault := gosoap.GetFault(body); fault != nil {
+		return fault
+	}
+
+	content := body.SelectElement(tag)
+	if content == nil {
+		return errors.New(fmt.Sprint("element <", tag, "> not found in response soap body"))
+	}
+	doc.SetRoot(content)
+	buf, err := doc.WriteToBytes()
+	if err != nil {
+		return err
+	}
+	return xml.Unmarshal(buf, v)
+}
+
+//CallMethod functions call an method, defined <method> struct with authentication data,
+//<authenticate> false leaves out the WS-Security header
+func (dev *Device) callMethodDo(ctx context.Context, endpoint string, method interface{}, headerFileds map[string]string, authenticate bool) (*http.Response, error) {
 	/*
 		Converting <method> struct to xml string representation
 	*/
@@ -257,9 +348,9 @@ func (dev *Device) callMethodDo(endpoint string, method interface{}, headerFiled
 	//Header handling for action
 	// this is not a must
 	//soap.AddAction()
-	//Auth Handling
-	if dev.login != "" && dev.password != "" {
-		soap.AddWSSecurity(dev.login, dev.password)
+	//Auth Handling, token created with the device clock
+	if authenticate && dev.authMode != AuthDigest && dev.login != "" && dev.password != "" {
+		soap.AddWSSecurityAt(dev.login, dev.password, dev.deviceTime())
 	}
 
 	if headerFileds != nil {
@@ -270,7 +361,39 @@ func (dev *Device) callMethodDo(endpoint string, method interface{}, headerFiled
 	/*
 		Sending request and returns the response
 	*/
-	return networking.SendSoap(endpoint, soap.String())
+	var cancel context.CancelFunc
+	if dev.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, dev.timeout)
+	}
+
+	var resp *http.Response
+	if dev.authMode != AuthWSSecurity && dev.digest != nil {
+		resp, err = networking.SendSoapDigestWith(ctx, dev.transport, endpoint, soap.String(), dev.digest)
+	} else {
+		resp, err = networking.SendSoapWith(ctx, dev.transport, endpoint, soap.String())
+	}
+
+	if cancel != nil {
+		if err != nil {
+			cancel()
+		} else {
+			//the deadline also covers reading the body
+			resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+		}
+	}
+	return resp, err
+}
+
+//cancelOnClose releases the call timeout when the response body is closed
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (body *cancelOnClose) Close() error {
+	err := body.ReadCloser.Close()
+	body.cancel()
+	return err
 }
 
 //GetXaddr GetXaddr
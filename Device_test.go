@@ -0,0 +1,115 @@
+package goonvif
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/use-go/goonvif/device"
+	"github.com/use-go/goonvif/gosoap"
+)
+
+const deviceEnvelope = `<SOAP-ENV:Envelope xmlns:SOAP-ENV="http://www.w3.org/2003/05/soap-envelope" xmlns:tt="http://www.onvif.org/ver10/schema"
+	xmlns:tds="http://www.onvif.org/ver10/device/wsdl" xmlns:ter="http://www.onvif.org/ver10/error"><SOAP-ENV:Body>%s</SOAP-ENV:Body></SOAP-ENV:Envelope>`
+
+//mockedDevice answers the requests by the soap body content returned by <answer> for the path and the request,
+//a fault is sent with status 500 and no content with status 400.
+//GetServices announces the device service when <answer> has no content for it
+func mockedDevice(answer func(path, request string) string) *httptest.Server {
+	return httptest.NewServer(deviceHandler(answer))
+}
+
+//deviceHandler the handler of mockedDevice, also served over https
+func deviceHandler(answer func(path, request string) string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		request := string(data)
+		body := answer(r.URL.Path, request)
+		if body == "" && strings.Contains(request, "GetServices") {
+			scheme := "http"
+			if r.TLS != nil {
+				scheme = "https"
+			}
+			body = `<tds:GetServicesResponse><tds:Service><tds:Namespace>http://www.onvif.org/ver10/device/wsdl</tds:Namespace>
+				<tds:XAddr>` + scheme + "://" + r.Host + `/onvif/device_service</tds:XAddr></tds:Service></tds:GetServicesResponse>`
+		}
+		switch {
+		case body == "":
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		case strings.Contains(body, "Fault>"):
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		w.Write([]byte(strings.Replace(deviceEnvelope, "%s", body, 1)))
+	})
+}
+
+//soapFault returns a sender fault of <subcode>
+func soapFault(subcode string) string {
+	return `<SOAP-ENV:Fault><SOAP-ENV:Code><SOAP-ENV:Value>SOAP-ENV:Sender</SOAP-ENV:Value>
+		<SOAP-ENV:Subcode><SOAP-ENV:Value>` + subcode + `</SOAP-ENV:Value></SOAP-ENV:Subcode></SOAP-ENV:Code>
+		<SOAP-ENV:Reason><SOAP-ENV:Text xml:lang="en">rejected</SOAP-ENV:Text></SOAP-ENV:Reason></SOAP-ENV:Fault>`
+}
+
+func TestCallMethodUnmarshal(t *testing.T) {
+
+	server := mockedDevice(func(path, request string) string {
+		switch {
+		case strings.Contains(request, "GetHostname"):
+			//the elements before the response are skipped
+			return `<tds:GetDNSResponse/><tds:GetHostnameResponse><tds:HostnameInformation>
+				<tt:FromDHCP>true</tt:FromDHCP><tt:Name>camera</tt:Name></tds:HostnameInformation></tds:GetHostnameResponse>`
+		case strings.Contains(request, "GetScopes"):
+			return soapFault("ter:InvalidArgVal")
+		case strings.Contains(request, "GetDNS"):
+			return `<tds:GetHostnameResponse/>`
+		}
+		return ""
+	})
+	defer server.Close()
+
+	dev, err := NewDevice(strings.TrimPrefix(server.URL, "http://"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	hostname, err := dev.Device().GetHostname(ctx, device.GetHostname{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if information := hostname.HostnameInformation; information.Name != "camera" || !bool(information.FromDHCP) {
+		t.Errorf("unexpected hostname %+v", information)
+	}
+
+	_, err = dev.Device().GetScopes(ctx, device.GetScopes{})
+	var fault *gosoap.Fault
+	if !errors.As(err, &fault) || !errors.Is(err, gosoap.ErrInvalidArgVal) || fault.Reason != "rejected" {
+		t.Errorf("expected fault, got %v", err)
+	}
+
+	if _, err = dev.Device().GetDNS(ctx, device.GetDNS{}); err == nil || !strings.Contains(err.Error(), "<GetDNSResponse>") {
+		t.Errorf("expected missing response element, got %v", err)
+	}
+}
+
+func TestResponseTag(t *testing.T) {
+
+	type getStatus struct{}
+	for _, test := range []struct {
+		method   interface{}
+		expected string
+	}{
+		{device.GetHostname{}, "GetHostnameResponse"},
+		{&device.GetHostname{}, "GetHostnameResponse"},
+		{getStatus{}, "getStatusResponse"},
+	} {
+		if tag := responseTag(test.method); tag != test.expected {
+			t.Errorf("expected %s for %T, got %s", test.expected, test.method, tag)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package event
 
 import (
+	"encoding/xml"
+
 	"github.com/use-go/goonvif/xsd"
 	"github.com/use-go/goonvif/xsd/onvif"
 )
@@ -71,8 +73,8 @@ type SubscriptionReference struct { //wsa http://www.w3.org/2005/08/addressing/w
 
 // FilterType struct
 type FilterType struct {
-	TopicExpression TopicExpressionType `xml:"wsnt:TopicExpression,omitempty"`
-	MessageContent  QueryExpressionType `xml:"wsnt:MessageContent,omitempty"`
+	TopicExpression *TopicExpressionType `xml:"wsnt:TopicExpression,omitempty"`
+	MessageContent  *QueryExpressionType `xml:"wsnt:MessageContent,omitempty"`
 }
 
 //ReferenceParametersType in ws-addr
@@ -397,6 +399,8 @@ type NotificationMessage NotificationMessageHolderType //wsnt http://docs.oasis-
 type QueryExpressionType struct { //wsnt http://docs.oasis-open.org/wsn/b-2.xsd
 	Dialect xsd.AnyURI `xml:"Dialect,attr"`
 	Value   xsd.String `xml:",chardata"` // boolean(ncex:Producer="15")
+	//Namespaces xmlns attributes binding the prefixes used by the expression
+	Namespaces []xml.Attr `xml:",any,attr"`
 }
 
 //MessageContentType Alias
@@ -409,6 +413,8 @@ type QueryExpression QueryExpressionType
 type TopicExpressionType struct { //wsnt http://docs.oasis-open.org/wsn/b-2.xsd
 	Dialect xsd.AnyURI `xml:"Dialect,attr"`
 	Value   xsd.String `xml:",chardata"`
+	//Namespaces xmlns attributes binding the prefixes used by the expression
+	Namespaces []xml.Attr `xml:",any,attr"`
 }
 
 //Topic Alias
@@ -0,0 +1,103 @@
+package media
+
+//go:generate go run ../cmd/clientgen -types types.go -package media -client Client -out media-client.go
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/use-go/goonvif"
+	"github.com/use-go/goonvif/xsd/onvif"
+)
+
+//Stream setup values of GetStreamUri
+const (
+	StreamTypeRTPUnicast   = onvif.StreamType("RTP-Unicast")
+	StreamTypeRTPMulticast = onvif.StreamType("RTP-Multicast")
+
+	TransportProtocolUDP  = onvif.TransportProtocol("UDP")
+	TransportProtocolTCP  = onvif.TransportProtocol("TCP")
+	TransportProtocolRTSP = onvif.TransportProtocol("RTSP")
+	TransportProtocolHTTP = onvif.TransportProtocol("HTTP")
+)
+
+//Video encodings of a VideoEncoderConfiguration
+const (
+	EncodingJPEG  = onvif.VideoEncoding("JPEG")
+	EncodingMPEG4 = onvif.VideoEncoding("MPEG4")
+	EncodingH264  = onvif.VideoEncoding("H264")
+)
+
+//ErrNoMatchingProfile returned when no media profile fits the request
+var ErrNoMatchingProfile = errors.New("no matching media profile")
+
+//Client typed client of the ONVIF Media service,
+//its methods are generated from types.go by cmd/clientgen
+type Client struct {
+	dev *goonvif.Device
+}
+
+//NewClient returns a Media service client of dev
+func NewClient(dev *goonvif.Device) *Client {
+	return &Client{dev: dev}
+}
+
+//ProfileStreamUri media profile with its stream uri
+type ProfileStreamUri struct {
+	Profile onvif.Profile
+	Uri     string
+}
+
+//GetStreamUris returns the stream uri of every media profile,
+//RTP unicast over <protocol> (e.g. TransportProtocolRTSP)
+func (c *Client) GetStreamUris(ctx context.Context, protocol onvif.TransportProtocol) ([]ProfileStreamUri, error) {
+	profiles, err := c.GetProfiles(ctx, GetProfiles{})
+	if err != nil {
+		return nil, err
+	}
+
+	uris := make([]ProfileStreamUri, 0, len(profiles.Profiles))
+	for _, profile := range profiles.Profiles {
+		uri, err := c.GetStreamUri(ctx, GetStreamUri{
+			StreamSetup: onvif.StreamSetup{
+				Stream:    StreamTypeRTPUnicast,
+				Transport: onvif.Transport{Protocol: protocol},
+			},
+			ProfileToken: profile.Token,
+		})
+		if err != nil {
+			return nil, err
+		}
+		uris = append(uris, ProfileStreamUri{Profile: profile, Uri: strings.TrimSpace(string(uri.MediaUri.Uri))})
+	}
+	return uris, nil
+}
+
+//GetHighestResolutionProfile returns the media profile with the largest
+//video resolution among those encoding with <encoding> (e.g. EncodingH264)
+func (c *Client) GetHighestResolutionProfile(ctx context.Context, encoding onvif.VideoEncoding) (*onvif.Profile, error) {
+	profiles, err := c.GetProfiles(ctx, GetProfiles{})
+	if err != nil {
+		return nil, err
+	}
+
+	profile, found := HighestResolutionProfile(profiles.Profiles, encoding)
+	if !found {
+		return nil, ErrNoMatchingProfile
+	}
+	return &profile, nil
+}
+
+//HighestResolutionProfile picks the profile with the most pixels whose video encoder uses <encoding>,
+//an empty encoding matches all profiles having a video encoder
+func HighestResolutionProfile(profiles []onvif.Profile, encoding onvif.VideoEncoding) (onvif.Profile, bool) {
+	best := onvif.HighestResolution(len(profiles), func(i int) (string, onvif.VideoResolution) {
+		encoder := profiles[i].VideoEncoderConfiguration
+		return string(encoder.Encoding), encoder.Resolution
+	}, string(encoding))
+	if best < 0 {
+		return onvif.Profile{}, false
+	}
+	return profiles[best], true
+}
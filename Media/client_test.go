@@ -0,0 +1,119 @@
+package media
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/use-go/goonvif"
+	"github.com/use-go/goonvif/xsd"
+	"github.com/use-go/goonvif/xsd/onvif"
+)
+
+const mediaEnvelope = `<SOAP-ENV:Envelope xmlns:SOAP-ENV="http://www.w3.org/2003/05/soap-envelope" xmlns:tt="http://www.onvif.org/ver10/schema"
+	xmlns:tds="http://www.onvif.org/ver10/device/wsdl" xmlns:trt="http://www.onvif.org/ver10/media/wsdl"><SOAP-ENV:Body>%s</SOAP-ENV:Body></SOAP-ENV:Envelope>`
+
+//profile returns a media profile of <token> with a video encoder of <encoding> at <width>x<height>,
+//none when the encoding is empty
+func profile(token string, encoding onvif.VideoEncoding, width, height int) onvif.Profile {
+	profile := onvif.Profile{Token: onvif.ReferenceToken(token)}
+	profile.VideoEncoderConfiguration.Encoding = encoding
+	profile.VideoEncoderConfiguration.Resolution = onvif.VideoResolution{Width: xsd.Int(width), Height: xsd.Int(height)}
+	return profile
+}
+
+func TestHighestResolutionProfile(t *testing.T) {
+
+	profiles := []onvif.Profile{
+		profile("jpeg", EncodingJPEG, 3840, 2160),
+		profile("sub", EncodingH264, 640, 480),
+		profile("main", "h264", 1920, 1080),
+		profile("main2", EncodingH264, 1080, 1920),
+		profile("audio", "", 0, 0),
+	}
+	for _, test := range []struct {
+		profiles []onvif.Profile
+		encoding onvif.VideoEncoding
+		expected onvif.ReferenceToken
+	}{
+		//the encoding is compared case insensitive and the first profile wins a tie
+		{profiles, EncodingH264, "main"},
+		{profiles, "", "jpeg"},
+		{profiles, EncodingMPEG4, ""},
+		{profiles[4:], "", ""},
+		{nil, EncodingH264, ""},
+	} {
+		best, found := HighestResolutionProfile(test.profiles, test.encoding)
+		if found != (test.expected != "") || best.Token != test.expected {
+			t.Errorf("expected %q for %q, got %q %v", test.expected, test.encoding, best.Token, found)
+		}
+	}
+}
+
+func TestGetStreamUris(t *testing.T) {
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		request := string(data)
+		body := ""
+		switch {
+		case strings.Contains(request, "GetServices"):
+			body = `<tds:GetServicesResponse><tds:Service><tds:Namespace>http://www.onvif.org/ver10/media/wsdl</tds:Namespace>
+				<tds:XAddr>` + server.URL + `/onvif/media</tds:XAddr></tds:Service></tds:GetServicesResponse>`
+		case strings.Contains(request, "GetProfiles"):
+			body = `<trt:GetProfilesResponse>
+				<trt:Profiles token="Profile_1"><tt:Name>main</tt:Name><tt:VideoEncoderConfiguration token="Encoder_1"><tt:Encoding>H264</tt:Encoding>
+				<tt:Resolution><tt:Width>1920</tt:Width><tt:Height>1080</tt:Height></tt:Resolution></tt:VideoEncoderConfiguration></trt:Profiles>
+				<trt:Profiles token="Profile_2"><tt:Name>sub</tt:Name><tt:VideoEncoderConfiguration token="Encoder_2"><tt:Encoding>H264</tt:Encoding>
+				<tt:Resolution><tt:Width>640</tt:Width><tt:Height>360</tt:Height></tt:Resolution></tt:VideoEncoderConfiguration></trt:Profiles>
+				</trt:GetProfilesResponse>`
+		case strings.Contains(request, "GetStreamUri"):
+			if !strings.Contains(request, "<onvif:Stream>RTP-Unicast</onvif:Stream>") || !strings.Contains(request, "<onvif:Protocol>RTSP</onvif:Protocol>") {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			token := "Profile_1"
+			if strings.Contains(request, "Profile_2") {
+				token = "Profile_2"
+			}
+			body = `<trt:GetStreamUriResponse><trt:MediaUri><tt:Uri> rtsp://camera/` + token + ` </tt:Uri></trt:MediaUri></trt:GetStreamUriResponse>`
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(strings.Replace(mediaEnvelope, "%s", body, 1)))
+	}))
+	defer server.Close()
+
+	dev, err := goonvif.NewDevice(strings.TrimPrefix(server.URL, "http://"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := NewClient(dev)
+	ctx := context.Background()
+
+	uris, err := client.GetStreamUris(ctx, TransportProtocolRTSP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(uris) != 2 || uris[0].Profile.Token != "Profile_1" || uris[0].Uri != "rtsp://camera/Profile_1" || uris[1].Uri != "rtsp://camera/Profile_2" {
+		t.Errorf("unexpected stream uris %+v", uris)
+	}
+
+	//the mocked device streams by RTSP only
+	if _, err = client.GetStreamUris(ctx, TransportProtocolUDP); err == nil {
+		t.Error("stream uri over UDP returned")
+	}
+
+	best, err := client.GetHighestResolutionProfile(ctx, EncodingH264)
+	if err != nil || best.Token != "Profile_1" {
+		t.Errorf("unexpected highest resolution profile %v %v", best, err)
+	}
+	if _, err = client.GetHighestResolutionProfile(ctx, EncodingJPEG); err != ErrNoMatchingProfile {
+		t.Errorf("expected no matching profile, got %v", err)
+	}
+}
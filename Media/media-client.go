@@ -0,0 +1,718 @@
+// Code generated by clientgen from Media/types.go; DO NOT EDIT.
+
+package media
+
+import (
+	"context"
+)
+
+//AddAudioDecoderConfiguration calls trt:AddAudioDecoderConfiguration and returns its response
+func (c *Client) AddAudioDecoderConfiguration(ctx context.Context, request AddAudioDecoderConfiguration) (*AddAudioDecoderConfigurationResponse, error) {
+	var response AddAudioDecoderConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//AddAudioEncoderConfiguration calls trt:AddAudioEncoderConfiguration and returns its response
+func (c *Client) AddAudioEncoderConfiguration(ctx context.Context, request AddAudioEncoderConfiguration) (*AddAudioEncoderConfigurationResponse, error) {
+	var response AddAudioEncoderConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//AddAudioOutputConfiguration calls trt:AddAudioOutputConfiguration and returns its response
+func (c *Client) AddAudioOutputConfiguration(ctx context.Context, request AddAudioOutputConfiguration) (*AddAudioOutputConfigurationResponse, error) {
+	var response AddAudioOutputConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//AddAudioSourceConfiguration calls trt:AddAudioSourceConfiguration and returns its response
+func (c *Client) AddAudioSourceConfiguration(ctx context.Context, request AddAudioSourceConfiguration) (*AddAudioSourceConfigurationResponse, error) {
+	var response AddAudioSourceConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//AddMetadataConfiguration calls trt:AddMetadataConfiguration and returns its response
+func (c *Client) AddMetadataConfiguration(ctx context.Context, request AddMetadataConfiguration) (*AddMetadataConfigurationResponse, error) {
+	var response AddMetadataConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//AddPTZConfiguration calls trt:AddPTZConfiguration and returns its response
+func (c *Client) AddPTZConfiguration(ctx context.Context, request AddPTZConfiguration) (*AddPTZConfigurationResponse, error) {
+	var response AddPTZConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//AddVideoAnalyticsConfiguration calls trt:AddVideoAnalyticsConfiguration and returns its response
+func (c *Client) AddVideoAnalyticsConfiguration(ctx context.Context, request AddVideoAnalyticsConfiguration) (*AddVideoAnalyticsConfigurationResponse, error) {
+	var response AddVideoAnalyticsConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//AddVideoEncoderConfiguration calls trt:AddVideoEncoderConfiguration and returns its response
+func (c *Client) AddVideoEncoderConfiguration(ctx context.Context, request AddVideoEncoderConfiguration) (*AddVideoEncoderConfigurationResponse, error) {
+	var response AddVideoEncoderConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//AddVideoSourceConfiguration calls trt:AddVideoSourceConfiguration and returns its response
+func (c *Client) AddVideoSourceConfiguration(ctx context.Context, request AddVideoSourceConfiguration) (*AddVideoSourceConfigurationResponse, error) {
+	var response AddVideoSourceConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//CreateOSD calls trt:CreateOSD and returns its response
+func (c *Client) CreateOSD(ctx context.Context, request CreateOSD) (*CreateOSDResponse, error) {
+	var response CreateOSDResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//CreateProfile calls trt:CreateProfile and returns its response
+func (c *Client) CreateProfile(ctx context.Context, request CreateProfile) (*CreateProfileResponse, error) {
+	var response CreateProfileResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//DeleteOSD calls trt:DeleteOSD and returns its response
+func (c *Client) DeleteOSD(ctx context.Context, request DeleteOSD) (*DeleteOSDResponse, error) {
+	var response DeleteOSDResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//DeleteProfile calls trt:DeleteProfile and returns its response
+func (c *Client) DeleteProfile(ctx context.Context, request DeleteProfile) (*DeleteProfileResponse, error) {
+	var response DeleteProfileResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetAudioDecoderConfiguration calls trt:GetAudioDecoderConfiguration and returns its response
+func (c *Client) GetAudioDecoderConfiguration(ctx context.Context, request GetAudioDecoderConfiguration) (*GetAudioDecoderConfigurationResponse, error) {
+	var response GetAudioDecoderConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetAudioDecoderConfigurationOptions calls trt:GetAudioDecoderConfigurationOptions and returns its response
+func (c *Client) GetAudioDecoderConfigurationOptions(ctx context.Context, request GetAudioDecoderConfigurationOptions) (*GetAudioDecoderConfigurationOptionsResponse, error) {
+	var response GetAudioDecoderConfigurationOptionsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetAudioDecoderConfigurations calls trt:GetAudioDecoderConfigurations and returns its response
+func (c *Client) GetAudioDecoderConfigurations(ctx context.Context, request GetAudioDecoderConfigurations) (*GetAudioDecoderConfigurationsResponse, error) {
+	var response GetAudioDecoderConfigurationsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetAudioEncoderConfiguration calls trt:GetAudioEncoderConfiguration and returns its response
+func (c *Client) GetAudioEncoderConfiguration(ctx context.Context, request GetAudioEncoderConfiguration) (*GetAudioEncoderConfigurationResponse, error) {
+	var response GetAudioEncoderConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetAudioEncoderConfigurationOptions calls trt:GetAudioEncoderConfigurationOptions and returns its response
+func (c *Client) GetAudioEncoderConfigurationOptions(ctx context.Context, request GetAudioEncoderConfigurationOptions) (*GetAudioEncoderConfigurationOptionsResponse, error) {
+	var response GetAudioEncoderConfigurationOptionsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetAudioEncoderConfigurations calls trt:GetAudioEncoderConfigurations and returns its response
+func (c *Client) GetAudioEncoderConfigurations(ctx context.Context, request GetAudioEncoderConfigurations) (*GetAudioEncoderConfigurationsResponse, error) {
+	var response GetAudioEncoderConfigurationsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetAudioOutputConfiguration calls trt:GetAudioOutputConfiguration and returns its response
+func (c *Client) GetAudioOutputConfiguration(ctx context.Context, request GetAudioOutputConfiguration) (*GetAudioOutputConfigurationResponse, error) {
+	var response GetAudioOutputConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetAudioOutputConfigurationOptions calls trt:GetAudioOutputConfigurationOptions and returns its response
+func (c *Client) GetAudioOutputConfigurationOptions(ctx context.Context, request GetAudioOutputConfigurationOptions) (*GetAudioOutputConfigurationOptionsResponse, error) {
+	var response GetAudioOutputConfigurationOptionsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetAudioOutputConfigurations calls trt:GetAudioOutputConfigurations and returns its response
+func (c *Client) GetAudioOutputConfigurations(ctx context.Context, request GetAudioOutputConfigurations) (*GetAudioOutputConfigurationsResponse, error) {
+	var response GetAudioOutputConfigurationsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetAudioOutputs calls trt:GetAudioOutputs and returns its response
+func (c *Client) GetAudioOutputs(ctx context.Context, request GetAudioOutputs) (*GetAudioOutputsResponse, error) {
+	var response GetAudioOutputsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetAudioSourceConfiguration calls trt:GetAudioSourceConfiguration and returns its response
+func (c *Client) GetAudioSourceConfiguration(ctx context.Context, request GetAudioSourceConfiguration) (*GetAudioSourceConfigurationResponse, error) {
+	var response GetAudioSourceConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetAudioSourceConfigurationOptions calls trt:GetAudioSourceConfigurationOptions and returns its response
+func (c *Client) GetAudioSourceConfigurationOptions(ctx context.Context, request GetAudioSourceConfigurationOptions) (*GetAudioSourceConfigurationOptionsResponse, error) {
+	var response GetAudioSourceConfigurationOptionsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetAudioSourceConfigurations calls trt:GetAudioSourceConfigurations and returns its response
+func (c *Client) GetAudioSourceConfigurations(ctx context.Context, request GetAudioSourceConfigurations) (*GetAudioSourceConfigurationsResponse, error) {
+	var response GetAudioSourceConfigurationsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetAudioSources calls trt:GetAudioSources and returns its response
+func (c *Client) GetAudioSources(ctx context.Context, request GetAudioSources) (*GetAudioSourcesResponse, error) {
+	var response GetAudioSourcesResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetCompatibleAudioDecoderConfigurations calls trt:GetCompatibleAudioDecoderConfigurations and returns its response
+func (c *Client) GetCompatibleAudioDecoderConfigurations(ctx context.Context, request GetCompatibleAudioDecoderConfigurations) (*GetCompatibleAudioDecoderConfigurationsResponse, error) {
+	var response GetCompatibleAudioDecoderConfigurationsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetCompatibleAudioEncoderConfigurations calls trt:GetCompatibleAudioEncoderConfigurations and returns its response
+func (c *Client) GetCompatibleAudioEncoderConfigurations(ctx context.Context, request GetCompatibleAudioEncoderConfigurations) (*GetCompatibleAudioEncoderConfigurationsResponse, error) {
+	var response GetCompatibleAudioEncoderConfigurationsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetCompatibleAudioOutputConfigurations calls trt:GetCompatibleAudioOutputConfigurations and returns its response
+func (c *Client) GetCompatibleAudioOutputConfigurations(ctx context.Context, request GetCompatibleAudioOutputConfigurations) (*GetCompatibleAudioOutputConfigurationsResponse, error) {
+	var response GetCompatibleAudioOutputConfigurationsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetCompatibleAudioSourceConfigurations calls trt:GetCompatibleAudioSourceConfigurations and returns its response
+func (c *Client) GetCompatibleAudioSourceConfigurations(ctx context.Context, request GetCompatibleAudioSourceConfigurations) (*GetCompatibleAudioSourceConfigurationsResponse, error) {
+	var response GetCompatibleAudioSourceConfigurationsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetCompatibleMetadataConfigurations calls trt:GetCompatibleMetadataConfigurations and returns its response
+func (c *Client) GetCompatibleMetadataConfigurations(ctx context.Context, request GetCompatibleMetadataConfigurations) (*GetCompatibleMetadataConfigurationsResponse, error) {
+	var response GetCompatibleMetadataConfigurationsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetCompatibleVideoAnalyticsConfigurations calls trt:GetCompatibleVideoAnalyticsConfigurations and returns its response
+func (c *Client) GetCompatibleVideoAnalyticsConfigurations(ctx context.Context, request GetCompatibleVideoAnalyticsConfigurations) (*GetCompatibleVideoAnalyticsConfigurationsResponse, error) {
+	var response GetCompatibleVideoAnalyticsConfigurationsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetCompatibleVideoEncoderConfigurations calls trt:GetCompatibleVideoEncoderConfigurations and returns its response
+func (c *Client) GetCompatibleVideoEncoderConfigurations(ctx context.Context, request GetCompatibleVideoEncoderConfigurations) (*GetCompatibleVideoEncoderConfigurationsResponse, error) {
+	var response GetCompatibleVideoEncoderConfigurationsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetCompatibleVideoSourceConfigurations calls trt:GetCompatibleVideoSourceConfigurations and returns its response
+func (c *Client) GetCompatibleVideoSourceConfigurations(ctx context.Context, request GetCompatibleVideoSourceConfigurations) (*GetCompatibleVideoSourceConfigurationsResponse, error) {
+	var response GetCompatibleVideoSourceConfigurationsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetGuaranteedNumberOfVideoEncoderInstances calls trt:GetGuaranteedNumberOfVideoEncoderInstances and returns its response
+func (c *Client) GetGuaranteedNumberOfVideoEncoderInstances(ctx context.Context, request GetGuaranteedNumberOfVideoEncoderInstances) (*GetGuaranteedNumberOfVideoEncoderInstancesResponse, error) {
+	var response GetGuaranteedNumberOfVideoEncoderInstancesResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetMetadataConfiguration calls trt:GetMetadataConfiguration and returns its response
+func (c *Client) GetMetadataConfiguration(ctx context.Context, request GetMetadataConfiguration) (*GetMetadataConfigurationResponse, error) {
+	var response GetMetadataConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetMetadataConfigurationOptions calls trt:GetMetadataConfigurationOptions and returns its response
+func (c *Client) GetMetadataConfigurationOptions(ctx context.Context, request GetMetadataConfigurationOptions) (*GetMetadataConfigurationOptionsResponse, error) {
+	var response GetMetadataConfigurationOptionsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetMetadataConfigurations calls trt:GetMetadataConfigurations and returns its response
+func (c *Client) GetMetadataConfigurations(ctx context.Context, request GetMetadataConfigurations) (*GetMetadataConfigurationsResponse, error) {
+	var response GetMetadataConfigurationsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetOSD calls trt:GetOSD and returns its response
+func (c *Client) GetOSD(ctx context.Context, request GetOSD) (*GetOSDResponse, error) {
+	var response GetOSDResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetOSDOptions calls trt:GetOSDOptions and returns its response
+func (c *Client) GetOSDOptions(ctx context.Context, request GetOSDOptions) (*GetOSDOptionsResponse, error) {
+	var response GetOSDOptionsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetOSDs calls trt:GetOSDs and returns its response
+func (c *Client) GetOSDs(ctx context.Context, request GetOSDs) (*GetOSDsResponse, error) {
+	var response GetOSDsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetProfile calls trt:GetProfile and returns its response
+func (c *Client) GetProfile(ctx context.Context, request GetProfile) (*GetProfileResponse, error) {
+	var response GetProfileResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetProfiles calls trt:GetProfiles and returns its response
+func (c *Client) GetProfiles(ctx context.Context, request GetProfiles) (*GetProfilesResponse, error) {
+	var response GetProfilesResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetServiceCapabilities calls trt:GetServiceCapabilities and returns its response
+func (c *Client) GetServiceCapabilities(ctx context.Context, request GetServiceCapabilities) (*GetServiceCapabilitiesResponse, error) {
+	var response GetServiceCapabilitiesResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetSnapshotUri calls trt:GetSnapshotUri and returns its response
+func (c *Client) GetSnapshotUri(ctx context.Context, request GetSnapshotUri) (*GetSnapshotUriResponse, error) {
+	var response GetSnapshotUriResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetStreamUri calls trt:GetStreamUri and returns its response
+func (c *Client) GetStreamUri(ctx context.Context, request GetStreamUri) (*GetStreamUriResponse, error) {
+	var response GetStreamUriResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetVideoAnalyticsConfiguration calls trt:GetVideoAnalyticsConfiguration and returns its response
+func (c *Client) GetVideoAnalyticsConfiguration(ctx context.Context, request GetVideoAnalyticsConfiguration) (*GetVideoAnalyticsConfigurationResponse, error) {
+	var response GetVideoAnalyticsConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetVideoAnalyticsConfigurations calls trt:GetVideoAnalyticsConfigurations and returns its response
+func (c *Client) GetVideoAnalyticsConfigurations(ctx context.Context, request GetVideoAnalyticsConfigurations) (*GetVideoAnalyticsConfigurationsResponse, error) {
+	var response GetVideoAnalyticsConfigurationsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetVideoEncoderConfiguration calls trt:GetVideoEncoderConfiguration and returns its response
+func (c *Client) GetVideoEncoderConfiguration(ctx context.Context, request GetVideoEncoderConfiguration) (*GetVideoEncoderConfigurationResponse, error) {
+	var response GetVideoEncoderConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetVideoEncoderConfigurationOptions calls trt:GetVideoEncoderConfigurationOptions and returns its response
+func (c *Client) GetVideoEncoderConfigurationOptions(ctx context.Context, request GetVideoEncoderConfigurationOptions) (*GetVideoEncoderConfigurationOptionsResponse, error) {
+	var response GetVideoEncoderConfigurationOptionsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetVideoEncoderConfigurations calls trt:GetVideoEncoderConfigurations and returns its response
+func (c *Client) GetVideoEncoderConfigurations(ctx context.Context, request GetVideoEncoderConfigurations) (*GetVideoEncoderConfigurationsResponse, error) {
+	var response GetVideoEncoderConfigurationsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetVideoSourceConfiguration calls trt:GetVideoSourceConfiguration and returns its response
+func (c *Client) GetVideoSourceConfiguration(ctx context.Context, request GetVideoSourceConfiguration) (*GetVideoSourceConfigurationResponse, error) {
+	var response GetVideoSourceConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetVideoSourceConfigurationOptions calls trt:GetVideoSourceConfigurationOptions and returns its response
+func (c *Client) GetVideoSourceConfigurationOptions(ctx context.Context, request GetVideoSourceConfigurationOptions) (*GetVideoSourceConfigurationOptionsResponse, error) {
+	var response GetVideoSourceConfigurationOptionsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetVideoSourceConfigurations calls trt:GetVideoSourceConfigurations and returns its response
+func (c *Client) GetVideoSourceConfigurations(ctx context.Context, request GetVideoSourceConfigurations) (*GetVideoSourceConfigurationsResponse, error) {
+	var response GetVideoSourceConfigurationsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetVideoSourceModes calls trt:GetVideoSourceModes and returns its response
+func (c *Client) GetVideoSourceModes(ctx context.Context, request GetVideoSourceModes) (*GetVideoSourceModesResponse, error) {
+	var response GetVideoSourceModesResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetVideoSources calls trt:GetVideoSources and returns its response
+func (c *Client) GetVideoSources(ctx context.Context, request GetVideoSources) (*GetVideoSourcesResponse, error) {
+	var response GetVideoSourcesResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//RemoveAudioDecoderConfiguration calls trt:RemoveAudioDecoderConfiguration and returns its response
+func (c *Client) RemoveAudioDecoderConfiguration(ctx context.Context, request RemoveAudioDecoderConfiguration) (*RemoveAudioDecoderConfigurationResponse, error) {
+	var response RemoveAudioDecoderConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//RemoveAudioEncoderConfiguration calls trt:RemoveAudioEncoderConfiguration and returns its response
+func (c *Client) RemoveAudioEncoderConfiguration(ctx context.Context, request RemoveAudioEncoderConfiguration) (*RemoveAudioEncoderConfigurationResponse, error) {
+	var response RemoveAudioEncoderConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//RemoveAudioOutputConfiguration calls trt:RemoveAudioOutputConfiguration and returns its response
+func (c *Client) RemoveAudioOutputConfiguration(ctx context.Context, request RemoveAudioOutputConfiguration) (*RemoveAudioOutputConfigurationResponse, error) {
+	var response RemoveAudioOutputConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//RemoveAudioSourceConfiguration calls trt:RemoveAudioSourceConfiguration and returns its response
+func (c *Client) RemoveAudioSourceConfiguration(ctx context.Context, request RemoveAudioSourceConfiguration) (*RemoveAudioSourceConfigurationResponse, error) {
+	var response RemoveAudioSourceConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//RemoveMetadataConfiguration calls trt:RemoveMetadataConfiguration and returns its response
+func (c *Client) RemoveMetadataConfiguration(ctx context.Context, request RemoveMetadataConfiguration) (*RemoveMetadataConfigurationResponse, error) {
+	var response RemoveMetadataConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//RemovePTZConfiguration calls trt:RemovePTZConfiguration and returns its response
+func (c *Client) RemovePTZConfiguration(ctx context.Context, request RemovePTZConfiguration) (*RemovePTZConfigurationResponse, error) {
+	var response RemovePTZConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//RemoveVideoAnalyticsConfiguration calls trt:RemoveVideoAnalyticsConfiguration and returns its response
+func (c *Client) RemoveVideoAnalyticsConfiguration(ctx context.Context, request RemoveVideoAnalyticsConfiguration) (*RemoveVideoAnalyticsConfigurationResponse, error) {
+	var response RemoveVideoAnalyticsConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//RemoveVideoEncoderConfiguration calls trt:RemoveVideoEncoderConfiguration and returns its response
+func (c *Client) RemoveVideoEncoderConfiguration(ctx context.Context, request RemoveVideoEncoderConfiguration) (*RemoveVideoEncoderConfigurationResponse, error) {
+	var response RemoveVideoEncoderConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//RemoveVideoSourceConfiguration calls trt:RemoveVideoSourceConfiguration and returns its response
+func (c *Client) RemoveVideoSourceConfiguration(ctx context.Context, request RemoveVideoSourceConfiguration) (*RemoveVideoSourceConfigurationResponse, error) {
+	var response RemoveVideoSourceConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetAudioDecoderConfiguration calls trt:SetAudioDecoderConfiguration and returns its response
+func (c *Client) SetAudioDecoderConfiguration(ctx context.Context, request SetAudioDecoderConfiguration) (*SetAudioDecoderConfigurationResponse, error) {
+	var response SetAudioDecoderConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetAudioEncoderConfiguration calls trt:SetAudioEncoderConfiguration and returns its response
+func (c *Client) SetAudioEncoderConfiguration(ctx context.Context, request SetAudioEncoderConfiguration) (*SetAudioEncoderConfigurationResponse, error) {
+	var response SetAudioEncoderConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetAudioOutputConfiguration calls trt:SetAudioOutputConfiguration and returns its response
+func (c *Client) SetAudioOutputConfiguration(ctx context.Context, request SetAudioOutputConfiguration) (*SetAudioOutputConfigurationResponse, error) {
+	var response SetAudioOutputConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetAudioSourceConfiguration calls trt:SetAudioSourceConfiguration and returns its response
+func (c *Client) SetAudioSourceConfiguration(ctx context.Context, request SetAudioSourceConfiguration) (*SetAudioSourceConfigurationResponse, error) {
+	var response SetAudioSourceConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetMetadataConfiguration calls trt:GetDeviceInformation and returns its response
+func (c *Client) SetMetadataConfiguration(ctx context.Context, request SetMetadataConfiguration) (*SetMetadataConfigurationResponse, error) {
+	var response SetMetadataConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetOSD calls trt:SetOSD and returns its response
+func (c *Client) SetOSD(ctx context.Context, request SetOSD) (*SetOSDResponse, error) {
+	var response SetOSDResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetSynchronizationPoint calls trt:SetSynchronizationPoint and returns its response
+func (c *Client) SetSynchronizationPoint(ctx context.Context, request SetSynchronizationPoint) (*SetSynchronizationPointResponse, error) {
+	var response SetSynchronizationPointResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetVideoAnalyticsConfiguration calls trt:SetVideoAnalyticsConfiguration and returns its response
+func (c *Client) SetVideoAnalyticsConfiguration(ctx context.Context, request SetVideoAnalyticsConfiguration) (*SetVideoAnalyticsConfigurationResponse, error) {
+	var response SetVideoAnalyticsConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetVideoEncoderConfiguration calls trt:SetVideoEncoderConfiguration and returns its response
+func (c *Client) SetVideoEncoderConfiguration(ctx context.Context, request SetVideoEncoderConfiguration) (*SetVideoEncoderConfigurationResponse, error) {
+	var response SetVideoEncoderConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetVideoSourceConfiguration calls trt:SetVideoSourceConfiguration and returns its response
+func (c *Client) SetVideoSourceConfiguration(ctx context.Context, request SetVideoSourceConfiguration) (*SetVideoSourceConfigurationResponse, error) {
+	var response SetVideoSourceConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetVideoSourceMode calls trt:SetVideoSourceMode and returns its response
+func (c *Client) SetVideoSourceMode(ctx context.Context, request SetVideoSourceMode) (*SetVideoSourceModeResponse, error) {
+	var response SetVideoSourceModeResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//StartMulticastStreaming calls trt:StartMulticastStreaming and returns its response
+func (c *Client) StartMulticastStreaming(ctx context.Context, request StartMulticastStreaming) (*StartMulticastStreamingResponse, error) {
+	var response StartMulticastStreamingResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//StopMulticastStreaming calls trt:StopMulticastStreaming and returns its response
+func (c *Client) StopMulticastStreaming(ctx context.Context, request StopMulticastStreaming) (*StopMulticastStreamingResponse, error) {
+	var response StopMulticastStreamingResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
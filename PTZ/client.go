@@ -0,0 +1,18 @@
+package ptz
+
+//go:generate go run ../cmd/clientgen -types types.go -package ptz -client Client -out ptz-client.go
+
+import (
+	"github.com/use-go/goonvif"
+)
+
+//Client typed client of the ONVIF PTZ service,
+//its methods are generated from types.go by cmd/clientgen
+type Client struct {
+	dev *goonvif.Device
+}
+
+//NewClient returns a PTZ service client of dev
+func NewClient(dev *goonvif.Device) *Client {
+	return &Client{dev: dev}
+}
@@ -0,0 +1,358 @@
+package ptz
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/use-go/goonvif"
+	media "github.com/use-go/goonvif/Media"
+	"github.com/use-go/goonvif/xsd"
+	"github.com/use-go/goonvif/xsd/onvif"
+)
+
+//ErrUnsupportedSpace returned when the node has no space of the kind and unit of a move
+var ErrUnsupportedSpace = errors.New("ptz node has no space of the requested unit")
+
+//ErrNoPTZConfiguration returned by NewController for media profiles without PTZ configuration
+var ErrNoPTZConfiguration = errors.New("media profile has no ptz configuration")
+
+//ErrNodeNotFound returned by NewController when the device has no node of the PTZ configuration
+var ErrNodeNotFound = errors.New("ptz node of the configuration not found")
+
+//Vector PTZ vector of a move, a nil part is not moved
+type Vector struct {
+	PanTilt *onvif.Vector2D `xml:"onvif:PanTilt,omitempty"`
+	Zoom    *onvif.Vector1D `xml:"onvif:Zoom,omitempty"`
+}
+
+type absoluteMove struct {
+	XMLName      string               `xml:"tptz:AbsoluteMove"`
+	ProfileToken onvif.ReferenceToken `xml:"tptz:ProfileToken"`
+	Position     Vector               `xml:"tptz:Position"`
+}
+
+type relativeMove struct {
+	XMLName      string               `xml:"tptz:RelativeMove"`
+	ProfileToken onvif.ReferenceToken `xml:"tptz:ProfileToken"`
+	Translation  Vector               `xml:"tptz:Translation"`
+}
+
+type continuousMove struct {
+	XMLName      string               `xml:"tptz:ContinuousMove"`
+	ProfileToken onvif.ReferenceToken `xml:"tptz:ProfileToken"`
+	Velocity     Vector               `xml:"tptz:Velocity"`
+	Timeout      xsd.Duration         `xml:"tptz:Timeout,omitempty"`
+}
+
+//Position of the PTZ unit in the units asked by Controller.Position
+type Position struct {
+	Pan, Tilt, Zoom float64
+	//PanTiltMoving and ZoomMoving whether the device reports the move status MOVING
+	PanTiltMoving bool
+	ZoomMoving    bool
+}
+
+//Controller moves the PTZ unit of a media profile in generic, degree or zoom factor coordinates.
+//It picks the space of the requested unit advertised by the node, converts from normalized
+//coordinates into the spaces of other units and clamps the moves to the ranges and limits
+type Controller struct {
+	client        *Client
+	profile       onvif.ReferenceToken
+	configuration onvif.PTZConfiguration
+	node          onvif.PTZNode
+	spaces        map[SpaceKind][]Space
+	limits        map[SpaceKind]Space
+}
+
+//NewController reads the PTZ configuration of the media profile <profileToken>, its node
+//and configuration options
+func NewController(ctx context.Context, dev *goonvif.Device, profileToken onvif.ReferenceToken) (*Controller, error) {
+	profile, err := media.NewClient(dev).GetProfile(ctx, media.GetProfile{ProfileToken: profileToken})
+	if err != nil {
+		return nil, err
+	}
+	configuration := profile.Profile.PTZConfiguration
+	if configuration.Token == "" {
+		return nil, ErrNoPTZConfiguration
+	}
+
+	c := &Controller{client: NewClient(dev), profile: profileToken, configuration: configuration}
+	nodes, err := c.client.GetNodes(ctx, GetNodes{})
+	if err != nil {
+		return nil, err
+	}
+	//the configured node, the first one when the configuration names none
+	nodeToken := strings.TrimSpace(string(configuration.NodeToken))
+	found := false
+	for _, node := range nodes.PTZNode {
+		if nodeToken == "" || strings.TrimSpace(string(node.Token)) == nodeToken {
+			c.node, found = node, true
+			break
+		}
+	}
+	if !found {
+		return nil, ErrNodeNotFound
+	}
+	options, err := c.client.GetConfigurationOptions(ctx, GetConfigurationOptions{ConfigurationToken: configuration.Token})
+	if err != nil {
+		return nil, err
+	}
+
+	//the spaces of the configuration options, the ones of the node for kinds without options
+	c.spaces = spacesOf(options.PTZConfigurationOptions.Spaces)
+	for kind, spaces := range spacesOf(c.node.SupportedPTZSpaces) {
+		if len(c.spaces[kind]) == 0 {
+			c.spaces[kind] = spaces
+		}
+	}
+	c.limits = make(map[SpaceKind]Space)
+	if limits := space2D([]onvif.Space2DDescription{configuration.PanTiltLimits.Range}); limits[0].URI != "" {
+		c.limits[AbsolutePanTilt] = limits[0]
+	}
+	if limits := space1D([]onvif.Space1DDescription{configuration.ZoomLimits.Range}); limits[0].URI != "" {
+		c.limits[AbsoluteZoom] = limits[0]
+	}
+	return c, nil
+}
+
+//Client returns the PTZ service client of the controller
+func (c *Controller) Client() *Client {
+	return c.client
+}
+
+//ProfileToken returns the media profile moved by the controller
+func (c *Controller) ProfileToken() onvif.ReferenceToken {
+	return c.profile
+}
+
+//Node returns the PTZ node of the profile
+func (c *Controller) Node() onvif.PTZNode {
+	return c.node
+}
+
+//Spaces returns the spaces of <kind> supported by the node
+func (c *Controller) Spaces(kind SpaceKind) []Space {
+	return c.spaces[kind]
+}
+
+//Space returns the space of <kind> in <unit>, false when the node has none
+func (c *Controller) Space(kind SpaceKind, unit Unit) (Space, bool) {
+	for _, space := range c.spaces[kind] {
+		if space.Unit == unit {
+			return space, true
+		}
+	}
+	return Space{}, false
+}
+
+//AbsoluteMove moves pan and tilt to the position in <unit>
+func (c *Controller) AbsoluteMove(ctx context.Context, pan, tilt float64, unit Unit) error {
+	vector, err := c.panTilt(AbsolutePanTilt, pan, tilt, unit)
+	if err != nil {
+		return err
+	}
+	return c.client.dev.CallMethodUnmarshal(ctx, absoluteMove{ProfileToken: c.profile, Position: Vector{PanTilt: vector}}, &AbsoluteMoveResponse{})
+}
+
+//AbsoluteZoom moves the zoom to the position in <unit>
+func (c *Controller) AbsoluteZoom(ctx context.Context, zoom float64, unit Unit) error {
+	vector, err := c.zoom(AbsoluteZoom, zoom, unit)
+	if err != nil {
+		return err
+	}
+	return c.client.dev.CallMethodUnmarshal(ctx, absoluteMove{ProfileToken: c.profile, Position: Vector{Zoom: vector}}, &AbsoluteMoveResponse{})
+}
+
+//RelativeMove moves pan and tilt by the translation in <unit>
+func (c *Controller) RelativeMove(ctx context.Context, pan, tilt float64, unit Unit) error {
+	vector, err := c.panTilt(RelativePanTilt, pan, tilt, unit)
+	if err != nil {
+		return err
+	}
+	return c.client.dev.CallMethodUnmarshal(ctx, relativeMove{ProfileToken: c.profile, Translation: Vector{PanTilt: vector}}, &RelativeMoveResponse{})
+}
+
+//RelativeZoom moves the zoom by the translation in <unit>
+func (c *Controller) RelativeZoom(ctx context.Context, zoom float64, unit Unit) error {
+	vector, err := c.zoom(RelativeZoom, zoom, unit)
+	if err != nil {
+		return err
+	}
+	return c.client.dev.CallMethodUnmarshal(ctx, relativeMove{ProfileToken: c.profile, Translation: Vector{Zoom: vector}}, &RelativeMoveResponse{})
+}
+
+//ContinuousMove moves with the normalized velocities -1..1 until Stop or <timeout>,
+//0 leaves the timeout to the device
+func (c *Controller) ContinuousMove(ctx context.Context, pan, tilt, zoom float64, timeout time.Duration) error {
+	var velocity Vector
+	var err error
+	if len(c.spaces[ContinuousPanTilt]) > 0 {
+		if velocity.PanTilt, err = c.panTilt(ContinuousPanTilt, pan, tilt, UnitNormalized); err != nil {
+			return err
+		}
+	}
+	if len(c.spaces[ContinuousZoom]) > 0 {
+		if velocity.Zoom, err = c.zoom(ContinuousZoom, zoom, UnitNormalized); err != nil {
+			return err
+		}
+	}
+	if velocity.PanTilt == nil && velocity.Zoom == nil {
+		return ErrUnsupportedSpace
+	}
+	request := continuousMove{ProfileToken: c.profile, Velocity: velocity}
+	if timeout > 0 {
+		request.Timeout = xsd.NewDuration(timeout)
+	}
+	return c.client.dev.CallMethodUnmarshal(ctx, request, &ContinuousMoveResponse{})
+}
+
+//Stop stops all moves
+func (c *Controller) Stop(ctx context.Context) error {
+	_, err := c.client.Stop(ctx, Stop{ProfileToken: c.profile, PanTilt: true, Zoom: true})
+	return err
+}
+
+//Position returns the position of GetStatus with pan/tilt in <panTiltUnit> and zoom in <zoomUnit>
+func (c *Controller) Position(ctx context.Context, panTiltUnit, zoomUnit Unit) (Position, error) {
+	position, _, err := c.status(ctx, panTiltUnit, zoomUnit)
+	return position, err
+}
+
+//status returns the position of GetStatus and whether the device reports its move status
+func (c *Controller) status(ctx context.Context, panTiltUnit, zoomUnit Unit) (Position, bool, error) {
+	status, err := c.client.GetStatus(ctx, GetStatus{ProfileToken: c.profile})
+	if err != nil {
+		return Position{}, false, err
+	}
+	reported := status.PTZStatus.Position
+	panTiltStatus := strings.ToUpper(strings.TrimSpace(status.PTZStatus.MoveStatus.PanTilt.Status))
+	zoomStatus := strings.ToUpper(strings.TrimSpace(status.PTZStatus.MoveStatus.Zoom.Status))
+	position := Position{PanTiltMoving: panTiltStatus == "MOVING", ZoomMoving: zoomStatus == "MOVING"}
+	known := panTiltStatus == "IDLE" || zoomStatus == "IDLE" || position.PanTiltMoving || position.ZoomMoving
+
+	if reported.PanTilt.Space != "" || len(c.spaces[AbsolutePanTilt]) > 0 {
+		from, err := c.reportedSpace(AbsolutePanTilt, reported.PanTilt.Space)
+		if err != nil {
+			return Position{}, false, err
+		}
+		to, err := c.unitRange(AbsolutePanTilt, panTiltUnit)
+		if err != nil {
+			return Position{}, false, err
+		}
+		position.Pan = convert(reported.PanTilt.X, from.XRange, to.XRange)
+		position.Tilt = convert(reported.PanTilt.Y, from.YRange, to.YRange)
+	}
+	if reported.Zoom.Space != "" || len(c.spaces[AbsoluteZoom]) > 0 {
+		from, err := c.reportedSpace(AbsoluteZoom, reported.Zoom.Space)
+		if err != nil {
+			return Position{}, false, err
+		}
+		to, err := c.unitRange(AbsoluteZoom, zoomUnit)
+		if err != nil {
+			return Position{}, false, err
+		}
+		position.Zoom = convert(reported.Zoom.X, from.XRange, to.XRange)
+	}
+	return position, known, nil
+}
+
+//panTilt returns the vector of x and y in <unit> in the space of <kind> used for the move
+func (c *Controller) panTilt(kind SpaceKind, x, y float64, unit Unit) (*onvif.Vector2D, error) {
+	space, x, y, err := c.target(kind, x, y, unit)
+	if err != nil {
+		return nil, err
+	}
+	return &onvif.Vector2D{X: x, Y: y, Space: space.URI}, nil
+}
+
+func (c *Controller) zoom(kind SpaceKind, x float64, unit Unit) (*onvif.Vector1D, error) {
+	space, x, _, err := c.target(kind, x, 0, unit)
+	if err != nil {
+		return nil, err
+	}
+	return &onvif.Vector1D{X: x, Space: space.URI}, nil
+}
+
+//target picks the space of <kind> for coordinates in <unit>: the space in <unit> or, for normalized
+//coordinates, the first space of <kind> they are converted into. The coordinates are clamped to
+//the ranges of the space and the limits of the configuration
+func (c *Controller) target(kind SpaceKind, x, y float64, unit Unit) (Space, float64, float64, error) {
+	space, found := c.Space(kind, unit)
+	if !found {
+		if unit != UnitNormalized || len(c.spaces[kind]) == 0 {
+			return Space{}, 0, 0, ErrUnsupportedSpace
+		}
+		space = c.spaces[kind][0]
+		normalized := normalizedRange(kind)
+		x, y = convert(x, normalized, space.XRange), convert(y, normalized, space.YRange)
+	}
+	x, y = space.Clamp(x, y)
+
+	if limits, found := c.limits[kind]; found {
+		if limits.URI != space.URI {
+			//limits of another space, converted by the ranges of both spaces
+			limitSpace, known := c.spaceOf(kind, limits.URI)
+			if !known {
+				return space, x, y, nil
+			}
+			limits = Space{
+				XRange: onvif.FloatRange{Min: convert(limits.XRange.Min, limitSpace.XRange, space.XRange), Max: convert(limits.XRange.Max, limitSpace.XRange, space.XRange)},
+				YRange: onvif.FloatRange{Min: convert(limits.YRange.Min, limitSpace.YRange, space.YRange), Max: convert(limits.YRange.Max, limitSpace.YRange, space.YRange)},
+			}
+		}
+		x, y = limits.Clamp(x, y)
+	}
+	return space, x, y, nil
+}
+
+//reportedSpace returns the space of a position of GetStatus, the first one of <kind> when it names none
+func (c *Controller) reportedSpace(kind SpaceKind, uri xsd.AnyURI) (Space, error) {
+	uri = xsd.AnyURI(strings.TrimSpace(string(uri)))
+	if uri == "" && len(c.spaces[kind]) > 0 {
+		return c.spaces[kind][0], nil
+	}
+	if space, found := c.spaceOf(kind, uri); found {
+		return space, nil
+	}
+	if SpaceUnit(uri) == UnitNormalized {
+		normalized := normalizedRange(kind)
+		return Space{URI: uri, Unit: UnitNormalized, XRange: normalized, YRange: normalized}, nil
+	}
+	return Space{}, ErrUnsupportedSpace
+}
+
+//unitRange returns the space of <kind> in <unit>, the normalized ranges when the node has no normalized space
+func (c *Controller) unitRange(kind SpaceKind, unit Unit) (Space, error) {
+	if space, found := c.Space(kind, unit); found {
+		return space, nil
+	}
+	if unit == UnitNormalized {
+		normalized := normalizedRange(kind)
+		return Space{Unit: UnitNormalized, XRange: normalized, YRange: normalized}, nil
+	}
+	return Space{}, ErrUnsupportedSpace
+}
+
+func (c *Controller) spaceOf(kind SpaceKind, uri xsd.AnyURI) (Space, bool) {
+	for _, space := range c.spaces[kind] {
+		if space.URI == uri {
+			return space, true
+		}
+	}
+	return Space{}, false
+}
+
+func spacesOf(spaces onvif.PTZSpaces) map[SpaceKind][]Space {
+	return map[SpaceKind][]Space{
+		AbsolutePanTilt:   space2D(spaces.AbsolutePanTiltPositionSpace),
+		AbsoluteZoom:      space1D(spaces.AbsoluteZoomPositionSpace),
+		RelativePanTilt:   space2D(spaces.RelativePanTiltTranslationSpace),
+		RelativeZoom:      space1D(spaces.RelativeZoomTranslationSpace),
+		ContinuousPanTilt: space2D(spaces.ContinuousPanTiltVelocitySpace),
+		ContinuousZoom:    space1D(spaces.ContinuousZoomVelocitySpace),
+		PanTiltSpeed:      space1D(spaces.PanTiltSpeedSpace),
+		ZoomSpeed:         space1D(spaces.ZoomSpeedSpace),
+	}
+}
@@ -0,0 +1,172 @@
+package ptz
+
+import (
+	"context"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/use-go/goonvif"
+)
+
+const ptzEnvelope = `<SOAP-ENV:Envelope xmlns:SOAP-ENV="http://www.w3.org/2003/05/soap-envelope" xmlns:tt="http://www.onvif.org/ver10/schema"
+	xmlns:tds="http://www.onvif.org/ver10/device/wsdl" xmlns:trt="http://www.onvif.org/ver10/media/wsdl"
+	xmlns:tptz="http://www.onvif.org/ver20/ptz/wsdl"><SOAP-ENV:Body>%s</SOAP-ENV:Body></SOAP-ENV:Envelope>`
+
+const ptzSpaces = `<tt:AbsolutePanTiltPositionSpace><tt:URI>http://www.onvif.org/ver10/tptz/PanTiltSpaces/PositionGenericSpace</tt:URI>
+	<tt:XRange><tt:Min>-1</tt:Min><tt:Max>1</tt:Max></tt:XRange><tt:YRange><tt:Min>-1</tt:Min><tt:Max>1</tt:Max></tt:YRange></tt:AbsolutePanTiltPositionSpace>
+	<tt:AbsolutePanTiltPositionSpace><tt:URI>http://www.onvif.org/ver10/tptz/PanTiltSpaces/SphericalPositionSpaceDegrees</tt:URI>
+	<tt:XRange><tt:Min>-170</tt:Min><tt:Max>170</tt:Max></tt:XRange><tt:YRange><tt:Min>-90</tt:Min><tt:Max>0</tt:Max></tt:YRange></tt:AbsolutePanTiltPositionSpace>
+	<tt:AbsoluteZoomPositionSpace><tt:URI>http://www.onvif.org/ver10/tptz/ZoomSpaces/PositionGenericSpace</tt:URI>
+	<tt:XRange><tt:Min>0</tt:Min><tt:Max>1</tt:Max></tt:XRange></tt:AbsoluteZoomPositionSpace>
+	<tt:ContinuousPanTiltVelocitySpace><tt:URI>http://www.onvif.org/ver10/tptz/PanTiltSpaces/VelocityGenericSpace</tt:URI>
+	<tt:XRange><tt:Min>-1</tt:Min><tt:Max>1</tt:Max></tt:XRange><tt:YRange><tt:Min>-1</tt:Min><tt:Max>1</tt:Max></tt:YRange></tt:ContinuousPanTiltVelocitySpace>`
+
+//mockedPTZDevice answers the requests of a controller, the moves and tour removals are sent on <moves>.
+//The pan tilt status of the polls follows <statuses>, the last one is repeated, IDLE without statuses
+func mockedPTZDevice(moves chan<- string, statuses ...string) *httptest.Server {
+	if len(statuses) == 0 {
+		statuses = []string{"IDLE"}
+	}
+	var statusPolls int32
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		request := string(data)
+		body := ""
+		switch {
+		case strings.Contains(request, "GetServices"):
+			body = `<tds:GetServicesResponse>
+				<tds:Service><tds:Namespace>http://www.onvif.org/ver10/media/wsdl</tds:Namespace><tds:XAddr>` + server.URL + `/onvif/media</tds:XAddr></tds:Service>
+				<tds:Service><tds:Namespace>http://www.onvif.org/ver20/ptz/wsdl</tds:Namespace><tds:XAddr>` + server.URL + `/onvif/ptz</tds:XAddr></tds:Service>
+				</tds:GetServicesResponse>`
+		case strings.Contains(request, "GetProfile"):
+			//the profile Profile_2 names a missing node
+			nodeToken := "Node_1"
+			if strings.Contains(request, "Profile_2") {
+				nodeToken = "Node_2"
+			}
+			body = `<trt:GetProfileResponse><trt:Profile token="Profile_1"><tt:Name>main</tt:Name>
+				<tt:PTZConfiguration token="PTZ_1"><tt:Name>ptz</tt:Name><tt:NodeToken>` + nodeToken + `</tt:NodeToken>
+				<tt:PanTiltLimits><tt:Range><tt:URI>http://www.onvif.org/ver10/tptz/PanTiltSpaces/PositionGenericSpace</tt:URI>
+				<tt:XRange><tt:Min>-0.5</tt:Min><tt:Max>0.5</tt:Max></tt:XRange><tt:YRange><tt:Min>-1</tt:Min><tt:Max>1</tt:Max></tt:YRange>
+				</tt:Range></tt:PanTiltLimits></tt:PTZConfiguration></trt:Profile></trt:GetProfileResponse>`
+		case strings.Contains(request, "GetNodes"):
+			body = `<tptz:GetNodesResponse><tptz:PTZNode token="Node_1"><tt:Name>dome</tt:Name>
+				<tt:SupportedPTZSpaces>` + ptzSpaces + `</tt:SupportedPTZSpaces></tptz:PTZNode></tptz:GetNodesResponse>`
+		case strings.Contains(request, "GetConfigurationOptions"):
+			body = `<tptz:GetConfigurationOptionsResponse><tptz:PTZConfigurationOptions><tt:Spaces>` + ptzSpaces +
+				`</tt:Spaces></tptz:PTZConfigurationOptions></tptz:GetConfigurationOptionsResponse>`
+		case strings.Contains(request, "AbsoluteMove"):
+			moves <- request
+			body = `<tptz:AbsoluteMoveResponse/>`
+		case strings.Contains(request, "GotoPreset"):
+			moves <- request
+			body = `<tptz:GotoPresetResponse/>`
+		case strings.Contains(request, "GotoHomePosition"):
+			moves <- request
+			body = `<tptz:GotoHomePositionResponse/>`
+		case strings.Contains(request, "CreatePresetTour"):
+			body = `<tptz:CreatePresetTourResponse><tptz:PresetTourToken>Tour_3</tptz:PresetTourToken></tptz:CreatePresetTourResponse>`
+		case strings.Contains(request, "ModifyPresetTour"):
+			//slower than the tests wait
+			time.Sleep(200 * time.Millisecond)
+			body = `<tptz:ModifyPresetTourResponse/>`
+		case strings.Contains(request, "RemovePresetTour"):
+			moves <- request
+			body = `<tptz:RemovePresetTourResponse/>`
+		case strings.Contains(request, "GetPresetTours"):
+			body = `<tptz:GetPresetToursResponse><tptz:PresetTour token="Tour_1"><tt:Name>gate</tt:Name>
+				<tt:Status><tt:State>Idle</tt:State></tt:Status><tt:AutoStart>true</tt:AutoStart>
+				<tt:StartingCondition RandomPresetOrder="false"><tt:RecurringTime>3</tt:RecurringTime><tt:Direction>Backward</tt:Direction></tt:StartingCondition>
+				<tt:TourSpot><tt:PresetDetail><tt:PresetToken>Preset_1</tt:PresetToken></tt:PresetDetail><tt:StayTime>PT1M30S</tt:StayTime></tt:TourSpot>
+				<tt:TourSpot><tt:PresetDetail><tt:Home>true</tt:Home></tt:PresetDetail><tt:StayTime>PT10S</tt:StayTime></tt:TourSpot>
+				</tptz:PresetTour></tptz:GetPresetToursResponse>`
+		case strings.Contains(request, "GetPresetTour"):
+			//a stay time of a month has no fixed length
+			body = `<tptz:GetPresetTourResponse><tptz:PresetTour token="Tour_2"><tt:Status><tt:State>Idle</tt:State></tt:Status>
+				<tt:TourSpot><tt:PresetDetail><tt:PresetToken>Preset_1</tt:PresetToken></tt:PresetDetail><tt:StayTime>P1M</tt:StayTime></tt:TourSpot>
+				</tptz:PresetTour></tptz:GetPresetTourResponse>`
+		case strings.Contains(request, "ContinuousMove"):
+			moves <- request
+			body = `<tptz:ContinuousMoveResponse/>`
+		case strings.Contains(request, "tptz:Stop"):
+			moves <- request
+			body = `<tptz:StopResponse/>`
+		case strings.Contains(request, "GetStatus"):
+			poll := int(atomic.AddInt32(&statusPolls, 1)) - 1
+			if poll >= len(statuses) {
+				poll = len(statuses) - 1
+			}
+			panTiltStatus := statuses[poll]
+			body = `<tptz:GetStatusResponse><tptz:PTZStatus><tt:Position>
+				<tt:PanTilt x="0.25" y="-0.5" space="http://www.onvif.org/ver10/tptz/PanTiltSpaces/PositionGenericSpace"/>
+				<tt:Zoom x="0.5" space="http://www.onvif.org/ver10/tptz/ZoomSpaces/PositionGenericSpace"/></tt:Position>
+				<tt:MoveStatus><tt:PanTilt>` + panTiltStatus + `</tt:PanTilt><tt:Zoom>IDLE</tt:Zoom></tt:MoveStatus>
+				</tptz:PTZStatus></tptz:GetStatusResponse>`
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(strings.Replace(ptzEnvelope, "%s", body, 1)))
+	}))
+	return server
+}
+
+//newTestController returns a controller of the profile Profile_1 of a mocked device, see mockedPTZDevice
+func newTestController(t *testing.T, moves chan<- string, statuses ...string) *Controller {
+	t.Helper()
+	server := mockedPTZDevice(moves, statuses...)
+	t.Cleanup(server.Close)
+
+	dev, err := goonvif.NewDevice(strings.TrimPrefix(server.URL, "http://"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	controller, err := NewController(context.Background(), dev, "Profile_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return controller
+}
+
+func TestController(t *testing.T) {
+
+	moves := make(chan string, 4)
+	controller := newTestController(t, moves, "MOVING")
+	ctx := context.Background()
+	if len(controller.Spaces(AbsolutePanTilt)) != 2 || controller.Node().Token != "Node_1" {
+		t.Fatalf("unexpected spaces %+v", controller.Spaces(AbsolutePanTilt))
+	}
+	if _, err := NewController(ctx, controller.client.dev, "Profile_2"); err != ErrNodeNotFound {
+		t.Errorf("expected missing node, got %v", err)
+	}
+
+	//degrees are clamped to the generic pan limits converted to degrees, -85..85
+	if err := controller.AbsoluteMove(ctx, 170, -45, UnitDegrees); err != nil {
+		t.Fatal(err)
+	}
+	move := <-moves
+	if !strings.Contains(move, `x="85"`) || !strings.Contains(move, `y="-45"`) || !strings.Contains(move, "SphericalPositionSpaceDegrees") ||
+		strings.Contains(move, "Zoom") {
+		t.Errorf("unexpected move %s", move)
+	}
+
+	if err := controller.AbsoluteZoom(ctx, 2, UnitZoomFactor); err != ErrUnsupportedSpace {
+		t.Errorf("zoom factor without space: %v", err)
+	}
+
+	position, err := controller.Position(ctx, UnitDegrees, UnitNormalized)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(position.Pan-42.5) > 1e-9 || math.Abs(position.Tilt+67.5) > 1e-9 || position.Zoom != 0.5 ||
+		!position.PanTiltMoving || position.ZoomMoving {
+		t.Errorf("unexpected position %+v", position)
+	}
+}
@@ -0,0 +1,162 @@
+package ptz
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/use-go/goonvif/xsd"
+	"github.com/use-go/goonvif/xsd/onvif"
+)
+
+//Unit of the coordinates of a space
+type Unit int
+
+//Units of the coordinate spaces
+const (
+	//UnitNormalized generic spaces, pan/tilt -1..1, zoom position and speeds 0..1
+	UnitNormalized Unit = iota
+	//UnitDegrees spherical spaces, e.g. pan -180..180
+	UnitDegrees
+	//UnitZoomFactor zoom magnification, e.g. 1..30
+	UnitZoomFactor
+	//UnitUnknown spaces of other units, e.g. field of view or focal length
+	UnitUnknown
+)
+
+//SpaceKind the move a space is used by
+type SpaceKind int
+
+//Kinds of the spaces of a PTZ node
+const (
+	AbsolutePanTilt SpaceKind = iota
+	AbsoluteZoom
+	RelativePanTilt
+	RelativeZoom
+	ContinuousPanTilt
+	ContinuousZoom
+	PanTiltSpeed
+	ZoomSpeed
+)
+
+//Coordinate spaces of the ONVIF PTZ specification
+const (
+	SpacePanTiltPositionGeneric    = xsd.AnyURI("http://www.onvif.org/ver10/tptz/PanTiltSpaces/PositionGenericSpace")
+	SpacePanTiltTranslationGeneric = xsd.AnyURI("http://www.onvif.org/ver10/tptz/PanTiltSpaces/TranslationGenericSpace")
+	SpacePanTiltVelocityGeneric    = xsd.AnyURI("http://www.onvif.org/ver10/tptz/PanTiltSpaces/VelocityGenericSpace")
+	SpacePanTiltSpeedGeneric       = xsd.AnyURI("http://www.onvif.org/ver10/tptz/PanTiltSpaces/GenericSpeedSpace")
+	SpacePanTiltPositionDegrees    = xsd.AnyURI("http://www.onvif.org/ver10/tptz/PanTiltSpaces/SphericalPositionSpaceDegrees")
+	SpacePanTiltTranslationDegrees = xsd.AnyURI("http://www.onvif.org/ver10/tptz/PanTiltSpaces/SphericalTranslationSpaceDegrees")
+	SpacePanTiltVelocityDegrees    = xsd.AnyURI("http://www.onvif.org/ver10/tptz/PanTiltSpaces/VelocitySpaceDegrees")
+	SpacePanTiltSpeedDegrees       = xsd.AnyURI("http://www.onvif.org/ver10/tptz/PanTiltSpaces/SpeedSpaceDegrees")
+	SpaceZoomPositionGeneric       = xsd.AnyURI("http://www.onvif.org/ver10/tptz/ZoomSpaces/PositionGenericSpace")
+	SpaceZoomTranslationGeneric    = xsd.AnyURI("http://www.onvif.org/ver10/tptz/ZoomSpaces/TranslationGenericSpace")
+	SpaceZoomVelocityGeneric       = xsd.AnyURI("http://www.onvif.org/ver10/tptz/ZoomSpaces/VelocityGenericSpace")
+	SpaceZoomSpeedGeneric          = xsd.AnyURI("http://www.onvif.org/ver10/tptz/ZoomSpaces/ZoomGenericSpeedSpace")
+)
+
+var (
+	spaceUnitsMutex sync.RWMutex
+	spaceUnits      = map[xsd.AnyURI]Unit{
+		SpacePanTiltPositionGeneric:    UnitNormalized,
+		SpacePanTiltTranslationGeneric: UnitNormalized,
+		SpacePanTiltVelocityGeneric:    UnitNormalized,
+		SpacePanTiltSpeedGeneric:       UnitNormalized,
+		SpacePanTiltPositionDegrees:    UnitDegrees,
+		SpacePanTiltTranslationDegrees: UnitDegrees,
+		SpacePanTiltVelocityDegrees:    UnitDegrees,
+		SpacePanTiltSpeedDegrees:       UnitDegrees,
+		SpaceZoomPositionGeneric:       UnitNormalized,
+		SpaceZoomTranslationGeneric:    UnitNormalized,
+		SpaceZoomVelocityGeneric:       UnitNormalized,
+		SpaceZoomSpeedGeneric:          UnitNormalized,
+	}
+)
+
+//RegisterSpaceUnit declares the unit of the vendor space <uri>, e.g. a zoom factor space
+func RegisterSpaceUnit(uri xsd.AnyURI, unit Unit) {
+	spaceUnitsMutex.Lock()
+	defer spaceUnitsMutex.Unlock()
+	spaceUnits[uri] = unit
+}
+
+//SpaceUnit returns the unit of the space <uri>. Unregistered spaces in degrees
+//or as zoom factor are recognized by their name
+func SpaceUnit(uri xsd.AnyURI) Unit {
+	spaceUnitsMutex.RLock()
+	unit, found := spaceUnits[uri]
+	spaceUnitsMutex.RUnlock()
+	if found {
+		return unit
+	}
+	name := strings.ToLower(string(uri))
+	switch {
+	case strings.Contains(name, "degrees"):
+		return UnitDegrees
+	case strings.Contains(name, "zoomfactor"):
+		return UnitZoomFactor
+	case strings.Contains(name, "generic"):
+		return UnitNormalized
+	}
+	return UnitUnknown
+}
+
+//Space a coordinate space of a PTZ node with its ranges, YRange is empty for 1D spaces
+type Space struct {
+	URI    xsd.AnyURI
+	Unit   Unit
+	XRange onvif.FloatRange
+	YRange onvif.FloatRange
+}
+
+//Clamp returns x and y within the ranges of the space
+func (space Space) Clamp(x, y float64) (float64, float64) {
+	return clamp(x, space.XRange), clamp(y, space.YRange)
+}
+
+//normalizedRange of the generic spaces of <kind>
+func normalizedRange(kind SpaceKind) onvif.FloatRange {
+	switch kind {
+	case AbsoluteZoom, PanTiltSpeed, ZoomSpeed:
+		return onvif.FloatRange{Min: 0, Max: 1}
+	}
+	return onvif.FloatRange{Min: -1, Max: 1}
+}
+
+func space2D(descriptions []onvif.Space2DDescription) []Space {
+	spaces := make([]Space, 0, len(descriptions))
+	for _, description := range descriptions {
+		uri := xsd.AnyURI(strings.TrimSpace(string(description.URI)))
+		spaces = append(spaces, Space{URI: uri, Unit: SpaceUnit(uri), XRange: description.XRange, YRange: description.YRange})
+	}
+	return spaces
+}
+
+func space1D(descriptions []onvif.Space1DDescription) []Space {
+	spaces := make([]Space, 0, len(descriptions))
+	for _, description := range descriptions {
+		uri := xsd.AnyURI(strings.TrimSpace(string(description.URI)))
+		spaces = append(spaces, Space{URI: uri, Unit: SpaceUnit(uri), XRange: description.XRange})
+	}
+	return spaces
+}
+
+//convert maps <value> linearly from the range <from> to the range <to>
+func convert(value float64, from, to onvif.FloatRange) float64 {
+	if from.Max == from.Min {
+		return to.Min
+	}
+	return to.Min + (value-from.Min)/(from.Max-from.Min)*(to.Max-to.Min)
+}
+
+func clamp(value float64, limits onvif.FloatRange) float64 {
+	if limits.Min == 0 && limits.Max == 0 {
+		return value
+	}
+	if value < limits.Min {
+		return limits.Min
+	}
+	if value > limits.Max {
+		return limits.Max
+	}
+	return value
+}
@@ -0,0 +1,163 @@
+package ptz
+
+import (
+	"context"
+	"time"
+)
+
+//velocity normalized pan, tilt and zoom velocity of a joystick
+type velocity struct {
+	pan, tilt, zoom float64
+}
+
+func (v velocity) zero() bool {
+	return v.pan == 0 && v.tilt == 0 && v.zoom == 0
+}
+
+//Joystick continuous moves of an operator: Move may be called at any rate, the velocities are
+//coalesced into ContinuousMove requests with a short Timeout, so the device stops by itself when
+//the client is gone. A Stop is sent when no Move arrives within Deadline and when Run ends:
+//
+//	joystick := ptz.NewJoystick(controller)
+//	go joystick.Run(ctx)
+//	joystick.Move(0.5, 0, 0) //key down
+//	joystick.Stop()          //key up
+type Joystick struct {
+	//MoveTimeout of the ContinuousMove requests, 1s when 0. Moves are repeated before it elapses
+	MoveTimeout time.Duration
+	//Deadline without Move after which the joystick stops, 500ms when 0
+	Deadline time.Duration
+	//Interval minimum between two requests, the moves in between are coalesced, 100ms when 0
+	Interval time.Duration
+	//OnError is called with the errors of the requests
+	OnError func(err error)
+
+	controller *Controller
+	updates    chan velocity
+}
+
+//NewJoystick returns a joystick moving by <controller>, its requests are sent by Run
+func NewJoystick(controller *Controller) *Joystick {
+	return &Joystick{controller: controller, updates: make(chan velocity, 1)}
+}
+
+//Move sets the normalized velocities -1..1, a pending velocity not sent yet is replaced
+func (j *Joystick) Move(pan, tilt, zoom float64) {
+	update := velocity{pan: pan, tilt: tilt, zoom: zoom}
+	for {
+		select {
+		case j.updates <- update:
+			return
+		default:
+		}
+		select {
+		case <-j.updates:
+		default:
+		}
+	}
+}
+
+//Stop stops the moves, like Move(0, 0, 0)
+func (j *Joystick) Stop() {
+	j.Move(0, 0, 0)
+}
+
+//Run sends the moves until ctx is done, a moving device is stopped before it returns.
+//It returns the error of the final Stop
+func (j *Joystick) Run(ctx context.Context) error {
+	moveTimeout := durationOr(j.MoveTimeout, time.Second)
+	deadline := durationOr(j.Deadline, 500*time.Millisecond)
+	interval := durationOr(j.Interval, 100*time.Millisecond)
+
+	var sent, target velocity
+	var moving, pending bool
+	var lastSent, lastUpdate time.Time
+
+	send := func(now time.Time, v velocity) {
+		var err error
+		if v.zero() {
+			if err = j.controller.Stop(ctx); err == nil {
+				moving = false
+			}
+		} else {
+			//a failed move may still have reached the device, it is stopped in any case
+			err = j.controller.ContinuousMove(ctx, v.pan, v.tilt, v.zoom, moveTimeout)
+			moving = true
+		}
+		sent, lastSent = v, now
+		if err != nil && ctx.Err() == nil {
+			j.onError(err)
+		}
+	}
+
+	timer := time.NewTimer(time.Hour)
+	defer timer.Stop()
+	for {
+		//the next request: a pending velocity, the watchdog or repeating the move before its timeout
+		var wake time.Time
+		switch {
+		case pending:
+			wake = lastSent.Add(interval)
+		case moving:
+			wake = lastSent.Add(moveTimeout / 2)
+			if watchdog := lastUpdate.Add(deadline); watchdog.Before(wake) {
+				wake = watchdog
+			}
+			if retry := lastSent.Add(interval); wake.Before(retry) {
+				wake = retry
+			}
+		}
+		var wakeup <-chan time.Time
+		if !wake.IsZero() {
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+			timer.Reset(time.Until(wake))
+			wakeup = timer.C
+		}
+
+		select {
+		case <-ctx.Done():
+			if !moving {
+				return nil
+			}
+			stopCtx, cancel := context.WithTimeout(context.Background(), moveTimeout)
+			defer cancel()
+			return j.controller.Stop(stopCtx)
+
+		case update := <-j.updates:
+			lastUpdate = time.Now()
+			target = update
+			//a repeated velocity only feeds the watchdog
+			pending = (moving && target != sent) || (!moving && !target.zero())
+
+		case now := <-wakeup:
+			switch {
+			case moving && !now.Before(lastUpdate.Add(deadline)):
+				pending = false
+				send(now, velocity{})
+			case pending:
+				pending = false
+				send(now, target)
+			case moving:
+				send(now, sent)
+			}
+		}
+	}
+}
+
+func (j *Joystick) onError(err error) {
+	if j.OnError != nil {
+		j.OnError(err)
+	}
+}
+
+func durationOr(d, defaultValue time.Duration) time.Duration {
+	if d <= 0 {
+		return defaultValue
+	}
+	return d
+}
@@ -0,0 +1,56 @@
+package ptz
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestJoystick(t *testing.T) {
+
+	moves := make(chan string, 64)
+	controller := newTestController(t, moves)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	joystick := NewJoystick(controller)
+	joystick.Deadline = 150 * time.Millisecond
+	joystick.Interval = 20 * time.Millisecond
+	joystick.MoveTimeout = time.Second
+	stopped := make(chan error, 1)
+	go func() { stopped <- joystick.Run(ctx) }()
+
+	//the updates of the same velocity are coalesced into one move with timeout
+	for i := 0; i < 50; i++ {
+		joystick.Move(0.5, 0, 0)
+		time.Sleep(time.Millisecond)
+	}
+	move := <-moves
+	if !strings.Contains(move, "ContinuousMove") || !strings.Contains(move, `x="0.5"`) || !strings.Contains(move, "<tptz:Timeout>PT1S</tptz:Timeout>") {
+		t.Errorf("unexpected move %s", move)
+	}
+
+	//the watchdog stops without updates
+	select {
+	case stop := <-moves:
+		if !strings.Contains(stop, "tptz:Stop") {
+			t.Errorf("expected stop, got %s", stop)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no stop by the watchdog")
+	}
+
+	//a move is stopped when the context ends
+	joystick.Move(0, -1, 0)
+	if move = <-moves; !strings.Contains(move, `y="-1"`) {
+		t.Errorf("unexpected move %s", move)
+	}
+	cancel()
+	if err := <-stopped; err != nil {
+		t.Fatal(err)
+	}
+	if stop := <-moves; !strings.Contains(stop, "tptz:Stop") {
+		t.Errorf("expected stop, got %s", stop)
+	}
+}
@@ -0,0 +1,123 @@
+package ptz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/use-go/goonvif/event"
+	"github.com/use-go/goonvif/xsd/onvif"
+)
+
+//MoveTimeoutError returned when the PTZ unit still moves after the timeout of a wait
+type MoveTimeoutError struct {
+	//Waited time of the wait
+	Waited time.Duration
+	//Position of the last poll
+	Position Position
+}
+
+func (err *MoveTimeoutError) Error() string {
+	return fmt.Sprintf("ptz still moving after %s", err.Waited)
+}
+
+//Timeout reports the error as timeout, like net.Error
+func (err *MoveTimeoutError) Timeout() bool {
+	return true
+}
+
+//WaitOptions of WaitForIdle
+type WaitOptions struct {
+	//Timeout of the wait, 30s when 0
+	Timeout time.Duration
+	//PollInterval before the first GetStatus, doubled after each poll up to MaxPollInterval, 100ms when 0
+	PollInterval time.Duration
+	//MaxPollInterval 1s when 0
+	MaxPollInterval time.Duration
+	//PanTiltUnit and ZoomUnit of the returned position
+	PanTiltUnit Unit
+	ZoomUnit    Unit
+	//Notifications of the device, e.g. of an event.PullPointSubscriber. The PTZController
+	//events, e.g. tns1:PTZController/PTZPresets/Reached, poll the status at once
+	Notifications <-chan event.NotificationMessage
+}
+
+type gotoPreset struct {
+	XMLName      string               `xml:"tptz:GotoPreset"`
+	ProfileToken onvif.ReferenceToken `xml:"tptz:ProfileToken"`
+	PresetToken  onvif.ReferenceToken `xml:"tptz:PresetToken"`
+	Speed        *Vector              `xml:"tptz:Speed,omitempty"`
+}
+
+//GotoPreset moves to the preset <presetToken> at the default speed of the configuration
+func (c *Controller) GotoPreset(ctx context.Context, presetToken onvif.ReferenceToken) error {
+	return c.client.dev.CallMethodUnmarshal(ctx, gotoPreset{ProfileToken: c.profile, PresetToken: presetToken}, &GotoPresetResponse{})
+}
+
+//MoveAndWait runs <move>, e.g. a call of AbsoluteMove or GotoPreset, and waits until the PTZ unit is idle
+func (c *Controller) MoveAndWait(ctx context.Context, move func(ctx context.Context) error, options WaitOptions) (Position, error) {
+	if err := move(ctx); err != nil {
+		return Position{}, err
+	}
+	return c.WaitForIdle(ctx, options)
+}
+
+//WaitForIdle polls GetStatus with backoff until neither pan/tilt nor zoom move and returns the final position.
+//Devices without move status are idle when the position stays the same between two polls.
+//A *MoveTimeoutError is returned after the timeout
+func (c *Controller) WaitForIdle(ctx context.Context, options WaitOptions) (Position, error) {
+	timeout := durationOr(options.Timeout, 30*time.Second)
+	interval := durationOr(options.PollInterval, 100*time.Millisecond)
+	maxInterval := durationOr(options.MaxPollInterval, time.Second)
+	notifications := options.Notifications
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	start := time.Now()
+
+	var last Position
+	polled := false
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-waitCtx.Done():
+			if ctx.Err() != nil {
+				return last, ctx.Err()
+			}
+			return last, &MoveTimeoutError{Waited: time.Since(start), Position: last}
+		case <-timer.C:
+		case message, ok := <-notifications:
+			if !ok {
+				notifications = nil
+				continue
+			}
+			if !strings.HasPrefix(event.TopicPath(string(message.Topic.Value)), "PTZController/") {
+				continue
+			}
+			if !timer.Stop() {
+				<-timer.C
+			}
+		}
+
+		position, known, err := c.status(waitCtx, options.PanTiltUnit, options.ZoomUnit)
+		if err != nil {
+			if waitCtx.Err() != nil {
+				//the timeout interrupted the poll
+				timer.Reset(0)
+				continue
+			}
+			return position, err
+		}
+		if !position.PanTiltMoving && !position.ZoomMoving && (known || (polled && position == last)) {
+			return position, nil
+		}
+		last, polled = position, true
+
+		if interval *= 2; interval > maxInterval {
+			interval = maxInterval
+		}
+		timer.Reset(interval)
+	}
+}
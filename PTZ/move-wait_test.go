@@ -0,0 +1,35 @@
+package ptz
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMoveAndWait(t *testing.T) {
+
+	moves := make(chan string, 4)
+	controller := newTestController(t, moves, "MOVING", "IDLE")
+	ctx := context.Background()
+
+	options := WaitOptions{PollInterval: 5 * time.Millisecond, Timeout: 5 * time.Second}
+	goPreset := func(ctx context.Context) error { return controller.GotoPreset(ctx, "Preset_1") }
+	position, err := controller.MoveAndWait(ctx, goPreset, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if move := <-moves; !strings.Contains(move, "<tptz:PresetToken>Preset_1</tptz:PresetToken>") || strings.Contains(move, "Speed") {
+		t.Errorf("unexpected move %s", move)
+	}
+	if position.PanTiltMoving || position.Pan != 0.25 || position.Zoom != 0.5 {
+		t.Errorf("unexpected position %+v", position)
+	}
+
+	//no poll before the timeout
+	options = WaitOptions{PollInterval: time.Second, Timeout: 20 * time.Millisecond}
+	_, err = controller.WaitForIdle(ctx, options)
+	if timeout, ok := err.(*MoveTimeoutError); !ok || !timeout.Timeout() {
+		t.Errorf("expected move timeout, got %v", err)
+	}
+}
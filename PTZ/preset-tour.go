@@ -0,0 +1,324 @@
+package ptz
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/use-go/goonvif/xsd"
+	"github.com/use-go/goonvif/xsd/onvif"
+)
+
+//TourDirection order of the steps of a preset tour
+type TourDirection string
+
+//Directions of the preset tours
+const (
+	TourForward  TourDirection = "Forward"
+	TourBackward TourDirection = "Backward"
+)
+
+//TourState of a preset tour
+type TourState string
+
+//States of the preset tours
+const (
+	TourIdle    TourState = "Idle"
+	TourTouring TourState = "Touring"
+	TourPaused  TourState = "Paused"
+)
+
+//TourOperation of OperatePresetTour
+type TourOperation string
+
+//Operations of the preset tours
+const (
+	TourStart TourOperation = "Start"
+	TourStop  TourOperation = "Stop"
+	TourPause TourOperation = "Pause"
+)
+
+//TourStep a preset of a tour and the time to stay there
+type TourStep struct {
+	//PresetToken of the preset, not used for the home position
+	PresetToken onvif.ReferenceToken
+	//Home moves to the home position instead of a preset
+	Home bool
+	//Speed normalized 0..1 of the move to the preset, the default speed of the configuration when 0
+	Speed float64
+	//StayTime at the preset
+	StayTime time.Duration
+}
+
+//Tour a preset tour, stored on the device by CreatePresetTour or emulated by a TourRunner
+type Tour struct {
+	Token onvif.ReferenceToken
+	Name  string
+	//State reported by the device, not sent by CreatePresetTour or ModifyPresetTour
+	State TourState
+	//AutoStart starts the tour on boot of the device
+	AutoStart bool
+	Direction TourDirection
+	//RandomOrder visits the steps in random order, instead of Direction
+	RandomOrder bool
+	//RecurringTime number of cycles and RecurringDuration of the tour, endless when both are 0
+	RecurringTime     int
+	RecurringDuration time.Duration
+	Steps             []TourStep
+}
+
+type tourStatus struct {
+	State TourState `xml:"onvif:State"`
+}
+
+type tourStartingCondition struct {
+	RandomPresetOrder xsd.Boolean   `xml:"RandomPresetOrder,attr,omitempty"`
+	RecurringTime     int           `xml:"onvif:RecurringTime,omitempty"`
+	RecurringDuration xsd.Duration  `xml:"onvif:RecurringDuration,omitempty"`
+	Direction         TourDirection `xml:"onvif:Direction,omitempty"`
+}
+
+//tourPresetDetail a choice of a preset or the home position
+type tourPresetDetail struct {
+	PresetToken onvif.ReferenceToken `xml:"onvif:PresetToken,omitempty"`
+	Home        xsd.Boolean          `xml:"onvif:Home,omitempty"`
+}
+
+type tourSpot struct {
+	PresetDetail tourPresetDetail `xml:"onvif:PresetDetail"`
+	Speed        *Vector          `xml:"onvif:Speed,omitempty"`
+	StayTime     xsd.Duration     `xml:"onvif:StayTime,omitempty"`
+}
+
+type presetTour struct {
+	Token             onvif.ReferenceToken  `xml:"token,attr,omitempty"`
+	Name              onvif.Name            `xml:"onvif:Name,omitempty"`
+	Status            tourStatus            `xml:"onvif:Status"`
+	AutoStart         xsd.Boolean           `xml:"onvif:AutoStart"`
+	StartingCondition tourStartingCondition `xml:"onvif:StartingCondition"`
+	TourSpot          []tourSpot            `xml:"onvif:TourSpot"`
+}
+
+type modifyPresetTour struct {
+	XMLName      string               `xml:"tptz:ModifyPresetTour"`
+	ProfileToken onvif.ReferenceToken `xml:"tptz:ProfileToken"`
+	PresetTour   presetTour           `xml:"tptz:PresetTour"`
+}
+
+type gotoHomePosition struct {
+	XMLName      string               `xml:"tptz:GotoHomePosition"`
+	ProfileToken onvif.ReferenceToken `xml:"tptz:ProfileToken"`
+	Speed        *Vector              `xml:"tptz:Speed,omitempty"`
+}
+
+//presetTourResponse the preset tour of GetPresetTour and GetPresetTours
+type presetTourResponse struct {
+	Token  onvif.ReferenceToken `xml:"token,attr"`
+	Name   onvif.Name
+	Status struct {
+		State TourState
+	}
+	AutoStart         xsd.Boolean
+	StartingCondition struct {
+		RandomPresetOrder xsd.Boolean `xml:"RandomPresetOrder,attr"`
+		RecurringTime     int
+		RecurringDuration xsd.Duration
+		Direction         TourDirection
+	}
+	TourSpot []struct {
+		PresetDetail struct {
+			PresetToken onvif.ReferenceToken
+			Home        xsd.Boolean
+		}
+		Speed    onvif.PTZVector2
+		StayTime xsd.Duration
+	}
+}
+
+type getPresetToursResponse struct {
+	PresetTour []presetTourResponse
+}
+
+type getPresetTourResponse struct {
+	PresetTour presetTourResponse
+}
+
+//SupportsPresetTours whether the node runs preset tours itself, a TourRunner emulates them otherwise
+func (c *Controller) SupportsPresetTours() bool {
+	return c.node.Extension.SupportedPresetTour.MaximumNumberOfPresetTours > 0
+}
+
+//PresetTours returns the preset tours of the profile stored on the device
+func (c *Controller) PresetTours(ctx context.Context) ([]Tour, error) {
+	var response getPresetToursResponse
+	if err := c.client.dev.CallMethodUnmarshal(ctx, GetPresetTours{ProfileToken: c.profile}, &response); err != nil {
+		return nil, err
+	}
+	tours := make([]Tour, 0, len(response.PresetTour))
+	for _, presetTour := range response.PresetTour {
+		tour, err := c.tour(presetTour)
+		if err != nil {
+			return nil, err
+		}
+		tours = append(tours, tour)
+	}
+	return tours, nil
+}
+
+//PresetTour returns the preset tour <token>
+func (c *Controller) PresetTour(ctx context.Context, token onvif.ReferenceToken) (Tour, error) {
+	var response getPresetTourResponse
+	if err := c.client.dev.CallMethodUnmarshal(ctx, GetPresetTour{ProfileToken: c.profile, PresetTourToken: token}, &response); err != nil {
+		return Tour{}, err
+	}
+	return c.tour(response.PresetTour)
+}
+
+//CreatePresetTour stores <tour> on the device and returns its token, the token of <tour> is ignored.
+//The created tour is removed again when <tour> can not be stored, an error of the removal is joined to the returned error
+func (c *Controller) CreatePresetTour(ctx context.Context, tour Tour) (onvif.ReferenceToken, error) {
+	created, err := c.client.CreatePresetTour(ctx, CreatePresetTour{ProfileToken: c.profile})
+	if err != nil {
+		return "", err
+	}
+	tour.Token = onvif.ReferenceToken(strings.TrimSpace(string(created.PresetTourToken)))
+	if err := c.ModifyPresetTour(ctx, tour); err != nil {
+		//no empty tour is left behind, removed under a context of its own as the failure may come from ctx
+		removeCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if removeErr := c.RemovePresetTour(removeCtx, tour.Token); removeErr != nil {
+			return "", errors.Join(err, removeErr)
+		}
+		return "", err
+	}
+	return tour.Token, nil
+}
+
+//ModifyPresetTour replaces the preset tour of the token of <tour>
+func (c *Controller) ModifyPresetTour(ctx context.Context, tour Tour) error {
+	return c.client.dev.CallMethodUnmarshal(ctx, modifyPresetTour{ProfileToken: c.profile, PresetTour: c.presetTour(tour)}, &ModifyPresetTourResponse{})
+}
+
+//OperatePresetTour starts, stops or pauses the preset tour <token> on the device
+func (c *Controller) OperatePresetTour(ctx context.Context, token onvif.ReferenceToken, operation TourOperation) error {
+	_, err := c.client.OperatePresetTour(ctx, OperatePresetTour{ProfileToken: c.profile, PresetTourToken: token, Operation: onvif.PTZPresetTourOperation(operation)})
+	return err
+}
+
+//RemovePresetTour removes the preset tour <token> from the device
+func (c *Controller) RemovePresetTour(ctx context.Context, token onvif.ReferenceToken) error {
+	_, err := c.client.RemovePresetTour(ctx, RemovePresetTour{ProfileToken: c.profile, PresetTourToken: token})
+	return err
+}
+
+//gotoStep moves to the preset or the home position of <step> at its speed
+func (c *Controller) gotoStep(ctx context.Context, step TourStep) error {
+	speed := c.speed(step.Speed)
+	if step.Home {
+		return c.client.dev.CallMethodUnmarshal(ctx, gotoHomePosition{ProfileToken: c.profile, Speed: speed}, &GotoHomePositionResponse{})
+	}
+	return c.client.dev.CallMethodUnmarshal(ctx, gotoPreset{ProfileToken: c.profile, PresetToken: step.PresetToken, Speed: speed}, &GotoPresetResponse{})
+}
+
+//speed returns the normalized <speed> in the speed spaces of the node, nil for the default speed
+func (c *Controller) speed(speed float64) *Vector {
+	if speed <= 0 {
+		return nil
+	}
+	var vector Vector
+	if space, x, _, err := c.target(PanTiltSpeed, speed, 0, UnitNormalized); err == nil {
+		vector.PanTilt = &onvif.Vector2D{X: x, Y: x, Space: space.URI}
+	}
+	if zoom, err := c.zoom(ZoomSpeed, speed, UnitNormalized); err == nil {
+		vector.Zoom = zoom
+	}
+	if vector.PanTilt == nil && vector.Zoom == nil {
+		return nil
+	}
+	return &vector
+}
+
+//normalizedSpeed returns the speed of a tour spot as normalized speed, 0 when it has none
+func (c *Controller) normalizedSpeed(speed onvif.PTZVector2) float64 {
+	if speed.PanTilt.Space != "" || speed.PanTilt.X != 0 {
+		if space, err := c.reportedSpace(PanTiltSpeed, speed.PanTilt.Space); err == nil {
+			return convert(speed.PanTilt.X, space.XRange, normalizedRange(PanTiltSpeed))
+		}
+	}
+	if speed.Zoom.Space != "" || speed.Zoom.X != 0 {
+		if space, err := c.reportedSpace(ZoomSpeed, speed.Zoom.Space); err == nil {
+			return convert(speed.Zoom.X, space.XRange, normalizedRange(ZoomSpeed))
+		}
+	}
+	return 0
+}
+
+func (c *Controller) presetTour(tour Tour) presetTour {
+	request := presetTour{
+		Token:     tour.Token,
+		Name:      onvif.Name(tour.Name),
+		Status:    tourStatus{State: TourIdle},
+		AutoStart: xsd.Boolean(tour.AutoStart),
+		StartingCondition: tourStartingCondition{
+			RandomPresetOrder: xsd.Boolean(tour.RandomOrder),
+			RecurringTime:     tour.RecurringTime,
+			Direction:         tour.Direction,
+		},
+	}
+	if tour.RecurringDuration > 0 {
+		request.StartingCondition.RecurringDuration = xsd.NewDuration(tour.RecurringDuration)
+	}
+	for _, step := range tour.Steps {
+		spot := tourSpot{Speed: c.speed(step.Speed)}
+		if step.Home {
+			spot.PresetDetail.Home = true
+		} else {
+			spot.PresetDetail.PresetToken = step.PresetToken
+		}
+		if step.StayTime > 0 {
+			spot.StayTime = xsd.NewDuration(step.StayTime)
+		}
+		request.TourSpot = append(request.TourSpot, spot)
+	}
+	return request
+}
+
+func (c *Controller) tour(response presetTourResponse) (Tour, error) {
+	condition := response.StartingCondition
+	recurringDuration, err := optionalDuration(condition.RecurringDuration)
+	if err != nil {
+		return Tour{}, err
+	}
+	tour := Tour{
+		Token:             onvif.ReferenceToken(strings.TrimSpace(string(response.Token))),
+		Name:              strings.TrimSpace(string(response.Name)),
+		State:             TourState(strings.TrimSpace(string(response.Status.State))),
+		AutoStart:         bool(response.AutoStart),
+		Direction:         TourDirection(strings.TrimSpace(string(condition.Direction))),
+		RandomOrder:       bool(condition.RandomPresetOrder),
+		RecurringTime:     condition.RecurringTime,
+		RecurringDuration: recurringDuration,
+	}
+	for _, spot := range response.TourSpot {
+		stayTime, err := optionalDuration(spot.StayTime)
+		if err != nil {
+			return Tour{}, err
+		}
+		tour.Steps = append(tour.Steps, TourStep{
+			PresetToken: onvif.ReferenceToken(strings.TrimSpace(string(spot.PresetDetail.PresetToken))),
+			Home:        bool(spot.PresetDetail.Home),
+			Speed:       c.normalizedSpeed(spot.Speed),
+			StayTime:    stayTime,
+		})
+	}
+	return tour, nil
+}
+
+//optionalDuration parses the xsd duration <value> of an optional element, 0 when it is empty
+func optionalDuration(value xsd.Duration) (time.Duration, error) {
+	if strings.TrimSpace(string(value)) == "" {
+		return 0, nil
+	}
+	return xsd.ParseDuration(value)
+}
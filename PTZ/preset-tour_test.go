@@ -0,0 +1,77 @@
+package ptz
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPresetTour(t *testing.T) {
+
+	moves := make(chan string, 16)
+	controller := newTestController(t, moves, "IDLE")
+	ctx := context.Background()
+
+	tours, err := controller.PresetTours(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tours) != 1 || tours[0].Token != "Tour_1" || tours[0].Direction != TourBackward || tours[0].RecurringTime != 3 || len(tours[0].Steps) != 2 {
+		t.Fatalf("unexpected tours %+v", tours)
+	}
+	if step := tours[0].Steps[0]; step.PresetToken != "Preset_1" || step.StayTime != 90*time.Second {
+		t.Errorf("unexpected first step %+v", step)
+	}
+	if step := tours[0].Steps[1]; !step.Home || step.StayTime != 10*time.Second {
+		t.Errorf("unexpected second step %+v", step)
+	}
+
+	if _, err := controller.PresetTour(ctx, "Tour_2"); err == nil {
+		t.Error("stay time of a month accepted")
+	}
+
+	//the tour created before the deadline is removed again
+	timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	if _, err := controller.CreatePresetTour(timeoutCtx, Tour{Steps: []TourStep{{Home: true}}}); err == nil {
+		t.Error("tour stored after the deadline")
+	}
+	if remove := <-moves; !strings.Contains(remove, "<tptz:PresetTourToken>Tour_3</tptz:PresetTourToken>") {
+		t.Errorf("expected removal of Tour_3, got %s", remove)
+	}
+
+	//the tour backward twice, emulated by the runner
+	tour := Tour{Direction: TourBackward, RecurringTime: 2, Steps: []TourStep{
+		{PresetToken: "Preset_1", StayTime: 10 * time.Millisecond},
+		{PresetToken: "Preset_2", StayTime: 10 * time.Millisecond},
+	}}
+	runner := NewTourRunner(controller, tour)
+	runner.Wait = WaitOptions{PollInterval: time.Millisecond}
+	if err := runner.Run(ctx); err != nil {
+		t.Fatal(err)
+	}
+	for _, preset := range []string{"Preset_2", "Preset_1", "Preset_2", "Preset_1"} {
+		if move := <-moves; !strings.Contains(move, "<tptz:PresetToken>"+preset+"</tptz:PresetToken>") {
+			t.Errorf("expected move to %s, got %s", preset, move)
+		}
+	}
+
+	//an endless tour stopped while paused
+	runner = NewTourRunner(controller, Tour{Steps: []TourStep{{Home: true, StayTime: time.Hour}}})
+	done := make(chan error)
+	go func() {
+		done <- runner.Run(ctx)
+	}()
+	if move := <-moves; !strings.Contains(move, "GotoHomePosition") {
+		t.Errorf("expected move home, got %s", move)
+	}
+	runner.Pause()
+	if state := runner.State(); state != TourPaused {
+		t.Errorf("expected paused tour, got %s", state)
+	}
+	runner.Stop()
+	if err := <-done; err != nil || runner.State() != TourIdle {
+		t.Errorf("unexpected end of the tour %v %s", err, runner.State())
+	}
+}
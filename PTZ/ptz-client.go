@@ -0,0 +1,259 @@
+// Code generated by clientgen from PTZ/types.go; DO NOT EDIT.
+
+package ptz
+
+import (
+	"context"
+)
+
+//AbsoluteMove calls tptz:AbsoluteMove and returns its response
+func (c *Client) AbsoluteMove(ctx context.Context, request AbsoluteMove) (*AbsoluteMoveResponse, error) {
+	var response AbsoluteMoveResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//ContinuousMove calls tptz:ContinuousMove and returns its response
+func (c *Client) ContinuousMove(ctx context.Context, request ContinuousMove) (*ContinuousMoveResponse, error) {
+	var response ContinuousMoveResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//CreatePresetTour calls tptz:CreatePresetTour and returns its response
+func (c *Client) CreatePresetTour(ctx context.Context, request CreatePresetTour) (*CreatePresetTourResponse, error) {
+	var response CreatePresetTourResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GeoMove calls tptz:GeoMove and returns its response
+func (c *Client) GeoMove(ctx context.Context, request GeoMove) (*GeoMoveResponse, error) {
+	var response GeoMoveResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetCompatibleConfigurations calls tptz:GetCompatibleConfigurations and returns its response
+func (c *Client) GetCompatibleConfigurations(ctx context.Context, request GetCompatibleConfigurations) (*GetCompatibleConfigurationsResponse, error) {
+	var response GetCompatibleConfigurationsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetConfiguration calls tptz:GetConfiguration and returns its response
+func (c *Client) GetConfiguration(ctx context.Context, request GetConfiguration) (*GetConfigurationResponse, error) {
+	var response GetConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetConfigurationOptions calls tptz:GetConfigurationOptions and returns its response
+func (c *Client) GetConfigurationOptions(ctx context.Context, request GetConfigurationOptions) (*GetConfigurationOptionsResponse, error) {
+	var response GetConfigurationOptionsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetConfigurations calls tptz:GetConfigurations and returns its response
+func (c *Client) GetConfigurations(ctx context.Context, request GetConfigurations) (*GetConfigurationsResponse, error) {
+	var response GetConfigurationsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetNode calls tptz:GetNode and returns its response
+func (c *Client) GetNode(ctx context.Context, request GetNode) (*GetNodeResponse, error) {
+	var response GetNodeResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetNodes calls tptz:GetNodes and returns its response
+func (c *Client) GetNodes(ctx context.Context, request GetNodes) (*GetNodesResponse, error) {
+	var response GetNodesResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetPresetTour calls tptz:GetPresetTour and returns its response
+func (c *Client) GetPresetTour(ctx context.Context, request GetPresetTour) (*GetPresetTourResponse, error) {
+	var response GetPresetTourResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetPresetTourOptions calls tptz:GetPresetTourOptions and returns its response
+func (c *Client) GetPresetTourOptions(ctx context.Context, request GetPresetTourOptions) (*GetPresetTourOptionsResponse, error) {
+	var response GetPresetTourOptionsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetPresetTours calls tptz:GetPresetTours and returns its response
+func (c *Client) GetPresetTours(ctx context.Context, request GetPresetTours) (*GetPresetToursResponse, error) {
+	var response GetPresetToursResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetPresets calls tptz:GetPresets and returns its response
+func (c *Client) GetPresets(ctx context.Context, request GetPresets) (*GetPresetsResponse, error) {
+	var response GetPresetsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetServiceCapabilities calls tptz:GetServiceCapabilities and returns its response
+func (c *Client) GetServiceCapabilities(ctx context.Context, request GetServiceCapabilities) (*GetServiceCapabilitiesResponse, error) {
+	var response GetServiceCapabilitiesResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetStatus calls tptz:GetStatus and returns its response
+func (c *Client) GetStatus(ctx context.Context, request GetStatus) (*GetStatusResponse, error) {
+	var response GetStatusResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GotoHomePosition calls tptz:GotoHomePosition and returns its response
+func (c *Client) GotoHomePosition(ctx context.Context, request GotoHomePosition) (*GotoHomePositionResponse, error) {
+	var response GotoHomePositionResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GotoPreset calls tptz:GotoPreset and returns its response
+func (c *Client) GotoPreset(ctx context.Context, request GotoPreset) (*GotoPresetResponse, error) {
+	var response GotoPresetResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//ModifyPresetTour calls tptz:ModifyPresetTour and returns its response
+func (c *Client) ModifyPresetTour(ctx context.Context, request ModifyPresetTour) (*ModifyPresetTourResponse, error) {
+	var response ModifyPresetTourResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//OperatePresetTour calls tptz:OperatePresetTour and returns its response
+func (c *Client) OperatePresetTour(ctx context.Context, request OperatePresetTour) (*OperatePresetTourResponse, error) {
+	var response OperatePresetTourResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//RelativeMove calls tptz:RelativeMove and returns its response
+func (c *Client) RelativeMove(ctx context.Context, request RelativeMove) (*RelativeMoveResponse, error) {
+	var response RelativeMoveResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//RemovePreset calls tptz:RemovePreset and returns its response
+func (c *Client) RemovePreset(ctx context.Context, request RemovePreset) (*RemovePresetResponse, error) {
+	var response RemovePresetResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//RemovePresetTour calls tptz:RemovePresetTour and returns its response
+func (c *Client) RemovePresetTour(ctx context.Context, request RemovePresetTour) (*RemovePresetTourResponse, error) {
+	var response RemovePresetTourResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SendAuxiliaryCommand calls tptz:SendAuxiliaryCommand and returns its response
+func (c *Client) SendAuxiliaryCommand(ctx context.Context, request SendAuxiliaryCommand) (*SendAuxiliaryCommandResponse, error) {
+	var response SendAuxiliaryCommandResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetConfiguration calls tptz:SetConfiguration and returns its response
+func (c *Client) SetConfiguration(ctx context.Context, request SetConfiguration) (*SetConfigurationResponse, error) {
+	var response SetConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetHomePosition calls tptz:SetHomePosition and returns its response
+func (c *Client) SetHomePosition(ctx context.Context, request SetHomePosition) (*SetHomePositionResponse, error) {
+	var response SetHomePositionResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetPreset calls tptz:SetPreset and returns its response
+func (c *Client) SetPreset(ctx context.Context, request SetPreset) (*SetPresetResponse, error) {
+	var response SetPresetResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//Stop calls tptz:Stop and returns its response
+func (c *Client) Stop(ctx context.Context, request Stop) (*StopResponse, error) {
+	var response StopResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
@@ -0,0 +1,242 @@
+package ptz
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+//errTourStopped ends the steps of a TourRunner after Stop
+var errTourStopped = errors.New("preset tour stopped")
+
+//TourRunner emulates a preset tour on devices without preset tour support: it moves to the
+//preset of each step by GotoPreset, waits until the unit is idle and stays for the stay time:
+//
+//	runner := ptz.NewTourRunner(controller, tour)
+//	go runner.Run(ctx)
+//	runner.Pause()
+//	runner.Resume()
+//	runner.Stop()
+type TourRunner struct {
+	//Wait options of the moves to the presets
+	Wait WaitOptions
+	//OnError is called with the errors of the steps, the tour continues with the next step
+	OnError func(err error)
+
+	controller *Controller
+	tour       Tour
+	mutex      sync.Mutex
+	state      TourState
+	stopped    bool
+	changed    chan struct{}
+}
+
+//NewTourRunner returns a runner of <tour> moving by <controller>, the tour is run by Run
+func NewTourRunner(controller *Controller, tour Tour) *TourRunner {
+	return &TourRunner{controller: controller, tour: tour, state: TourIdle, changed: make(chan struct{})}
+}
+
+//State returns the state of the tour
+func (r *TourRunner) State() TourState {
+	state, _, _ := r.watch()
+	return state
+}
+
+//Pause pauses the tour, a move in progress is stopped
+func (r *TourRunner) Pause() {
+	r.set(func() {
+		if r.state == TourTouring {
+			r.state = TourPaused
+		}
+	})
+}
+
+//Resume continues a paused tour: an interrupted move is repeated, an interrupted stay continues
+func (r *TourRunner) Resume() {
+	r.set(func() {
+		if r.state == TourPaused {
+			r.state = TourTouring
+		}
+	})
+}
+
+//Stop ends the tour, a move in progress is stopped and Run returns
+func (r *TourRunner) Stop() {
+	r.set(func() {
+		r.stopped = true
+	})
+}
+
+//Run runs the tour until its recurrences are done, Stop is called or ctx is done, a runner is run once.
+//It returns ctx.Err() when ctx is done and the last error when all steps of a cycle failed
+func (r *TourRunner) Run(ctx context.Context) error {
+	if len(r.tour.Steps) == 0 {
+		return nil
+	}
+	r.set(func() {
+		if !r.stopped {
+			r.state = TourTouring
+		}
+	})
+	defer r.set(func() {
+		r.state = TourIdle
+	})
+
+	tourCtx := ctx
+	if r.tour.RecurringDuration > 0 {
+		var cancel context.CancelFunc
+		tourCtx, cancel = context.WithTimeout(ctx, r.tour.RecurringDuration)
+		defer cancel()
+	}
+	for cycle := 0; r.tour.RecurringTime <= 0 || cycle < r.tour.RecurringTime; cycle++ {
+		var err error
+		failed := 0
+		steps := r.order()
+		for _, step := range steps {
+			err = r.step(tourCtx, step)
+			switch {
+			case err == errTourStopped:
+				return nil
+			case tourCtx.Err() != nil:
+				//nil when the recurring duration elapsed
+				return ctx.Err()
+			case err != nil:
+				failed++
+				r.onError(err)
+			}
+		}
+		if failed == len(steps) {
+			return err
+		}
+	}
+	return nil
+}
+
+//order returns the steps of a cycle
+func (r *TourRunner) order() []TourStep {
+	steps := append([]TourStep(nil), r.tour.Steps...)
+	switch {
+	case r.tour.RandomOrder:
+		rand.Shuffle(len(steps), func(i, j int) {
+			steps[i], steps[j] = steps[j], steps[i]
+		})
+	case r.tour.Direction == TourBackward:
+		for i, j := 0, len(steps)-1; i < j; i, j = i+1, j-1 {
+			steps[i], steps[j] = steps[j], steps[i]
+		}
+	}
+	return steps
+}
+
+//step moves to the preset of <step> and stays there, a pause interrupts the move and the stay
+func (r *TourRunner) step(ctx context.Context, step TourStep) error {
+	for {
+		if err := r.waitResumed(ctx); err != nil {
+			return err
+		}
+		moveCtx, cancel := r.pausable(ctx)
+		_, err := r.controller.MoveAndWait(moveCtx, func(ctx context.Context) error {
+			return r.controller.gotoStep(ctx, step)
+		}, r.Wait)
+		interrupted := err != nil && moveCtx.Err() != nil && ctx.Err() == nil
+		cancel()
+		if !interrupted {
+			if err != nil {
+				return err
+			}
+			break
+		}
+		r.stopMove()
+	}
+
+	for remaining := step.StayTime; remaining > 0; {
+		if err := r.waitResumed(ctx); err != nil {
+			return err
+		}
+		start := time.Now()
+		stayCtx, cancel := r.pausable(ctx)
+		timer := time.NewTimer(remaining)
+		select {
+		case <-timer.C:
+			remaining = 0
+		case <-stayCtx.Done():
+			remaining -= time.Since(start)
+		}
+		timer.Stop()
+		cancel()
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+//stopMove stops a move interrupted by Pause or Stop
+func (r *TourRunner) stopMove() {
+	stopCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := r.controller.Stop(stopCtx); err != nil {
+		r.onError(err)
+	}
+}
+
+//waitResumed blocks while the tour is paused, errTourStopped after Stop
+func (r *TourRunner) waitResumed(ctx context.Context) error {
+	for {
+		state, stopped, changed := r.watch()
+		if stopped {
+			return errTourStopped
+		}
+		if state != TourPaused {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+//pausable returns a context of ctx cancelled by Pause and Stop
+func (r *TourRunner) pausable(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		for {
+			state, stopped, changed := r.watch()
+			if stopped || state == TourPaused {
+				cancel()
+				return
+			}
+			select {
+			case <-changed:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ctx, cancel
+}
+
+//set changes the state by <change> and wakes up the waits for a change
+func (r *TourRunner) set(change func()) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	change()
+	close(r.changed)
+	r.changed = make(chan struct{})
+}
+
+func (r *TourRunner) watch() (TourState, bool, <-chan struct{}) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.state, r.stopped, r.changed
+}
+
+func (r *TourRunner) onError(err error) {
+	if r.OnError != nil {
+		r.OnError(err)
+	}
+}
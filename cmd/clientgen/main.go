@@ -0,0 +1,157 @@
+//clientgen generates typed client methods from the request/response structs of a service package.
+//
+//Every struct <Name> with an XMLName field and a sibling <Name>Response struct becomes
+//
+//	func (c *<client>) <Name>(ctx context.Context, request <Name>) (*<Name>Response, error)
+//
+//Usage:
+//
+//	go run ./cmd/clientgen -types Media/types.go -package media -client Client -out Media/client.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	typesFile  = flag.String("types", "", "go file declaring the request/response structs")
+	pkgName    = flag.String("package", "", "package name of the generated file")
+	importPath = flag.String("import", "", "import path of the types package, empty if it is the generated package")
+	clientName = flag.String("client", "Client", "receiver type of the generated methods")
+	outFile    = flag.String("out", "", "output file")
+)
+
+//operation request struct and its soap element name
+type operation struct {
+	Name    string
+	Element string
+}
+
+func main() {
+	flag.Parse()
+	if *typesFile == "" || *pkgName == "" || *outFile == "" {
+		flag.Usage()
+		log.Fatal("-types, -package and -out are required")
+	}
+
+	operations, err := parseOperations(*typesFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	src, err := generate(operations)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*outFile, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+//parseOperations collects request structs which have a matching <Name>Response struct
+func parseOperations(filename string) ([]operation, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), filename, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	structs := make(map[string]*ast.StructType)
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			if structType, ok := typeSpec.Type.(*ast.StructType); ok {
+				structs[typeSpec.Name.Name] = structType
+			}
+		}
+	}
+
+	var operations []operation
+	for name, structType := range structs {
+		if strings.HasSuffix(name, "Response") {
+			continue
+		}
+		if _, found := structs[name+"Response"]; !found {
+			continue
+		}
+		element := xmlName(structType)
+		if element == "" {
+			continue
+		}
+		operations = append(operations, operation{Name: name, Element: element})
+	}
+	sort.Slice(operations, func(i, j int) bool { return operations[i].Name < operations[j].Name })
+
+	return operations, nil
+}
+
+//xmlName returns the element name in the XMLName tag of a struct
+func xmlName(structType *ast.StructType) string {
+	for _, field := range structType.Fields.List {
+		if len(field.Names) != 1 || field.Names[0].Name != "XMLName" || field.Tag == nil {
+			continue
+		}
+		tag, err := strconv.Unquote(field.Tag.Value)
+		if err != nil {
+			return ""
+		}
+		return strings.Split(reflect.StructTag(tag).Get("xml"), ",")[0]
+	}
+	return ""
+}
+
+func generate(operations []operation) ([]byte, error) {
+	qualifier := ""
+	if *importPath != "" {
+		qualifier = path.Base(*importPath) + "."
+	}
+
+	source := *typesFile
+	if abs, err := filepath.Abs(source); err == nil {
+		source = filepath.Base(filepath.Dir(abs)) + "/" + filepath.Base(abs)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by clientgen from %s; DO NOT EDIT.\n\n", source)
+	fmt.Fprintf(&buf, "package %s\n\n", *pkgName)
+	fmt.Fprintf(&buf, "import (\n\t\"context\"\n")
+	if *importPath != "" {
+		fmt.Fprintf(&buf, "\n\t%q\n", *importPath)
+	}
+	fmt.Fprintf(&buf, ")\n")
+
+	for _, op := range operations {
+		fmt.Fprintf(&buf, `
+//%[1]s calls %[4]s and returns its response
+func (c *%[2]s) %[1]s(ctx context.Context, request %[3]s%[1]s) (*%[3]s%[1]sResponse, error) {
+	var response %[3]s%[1]sResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+`, op.Name, *clientName, qualifier, op.Element)
+	}
+
+	//keep the output as written, only make sure it is valid go source
+	if _, err := parser.ParseFile(token.NewFileSet(), *outFile, buf.Bytes(), parser.ParseComments); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
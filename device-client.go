@@ -0,0 +1,819 @@
+// Code generated by clientgen from Device/types.go; DO NOT EDIT.
+
+package goonvif
+
+import (
+	"context"
+
+	"github.com/use-go/goonvif/device"
+)
+
+//AddIPAddressFilter calls tds:AddIPAddressFilter and returns its response
+func (c *DeviceClient) AddIPAddressFilter(ctx context.Context, request device.AddIPAddressFilter) (*device.AddIPAddressFilterResponse, error) {
+	var response device.AddIPAddressFilterResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//AddScopes calls tds:AddScopes and returns its response
+func (c *DeviceClient) AddScopes(ctx context.Context, request device.AddScopes) (*device.AddScopesResponse, error) {
+	var response device.AddScopesResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//CreateCertificate calls tds:CreateCertificate and returns its response
+func (c *DeviceClient) CreateCertificate(ctx context.Context, request device.CreateCertificate) (*device.CreateCertificateResponse, error) {
+	var response device.CreateCertificateResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//CreateDot1XConfiguration calls tds:CreateDot1XConfiguration and returns its response
+func (c *DeviceClient) CreateDot1XConfiguration(ctx context.Context, request device.CreateDot1XConfiguration) (*device.CreateDot1XConfigurationResponse, error) {
+	var response device.CreateDot1XConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//CreateStorageConfiguration calls tds:CreateStorageConfiguration and returns its response
+func (c *DeviceClient) CreateStorageConfiguration(ctx context.Context, request device.CreateStorageConfiguration) (*device.CreateStorageConfigurationResponse, error) {
+	var response device.CreateStorageConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//CreateUsers calls tds:CreateUsers and returns its response
+func (c *DeviceClient) CreateUsers(ctx context.Context, request device.CreateUsers) (*device.CreateUsersResponse, error) {
+	var response device.CreateUsersResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//DeleteCertificates calls tds:DeleteCertificates and returns its response
+func (c *DeviceClient) DeleteCertificates(ctx context.Context, request device.DeleteCertificates) (*device.DeleteCertificatesResponse, error) {
+	var response device.DeleteCertificatesResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//DeleteDot1XConfiguration calls tds:DeleteDot1XConfiguration and returns its response
+func (c *DeviceClient) DeleteDot1XConfiguration(ctx context.Context, request device.DeleteDot1XConfiguration) (*device.DeleteDot1XConfigurationResponse, error) {
+	var response device.DeleteDot1XConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//DeleteGeoLocation calls tds:DeleteGeoLocation and returns its response
+func (c *DeviceClient) DeleteGeoLocation(ctx context.Context, request device.DeleteGeoLocation) (*device.DeleteGeoLocationResponse, error) {
+	var response device.DeleteGeoLocationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//DeleteStorageConfiguration calls tds:DeleteStorageConfiguration and returns its response
+func (c *DeviceClient) DeleteStorageConfiguration(ctx context.Context, request device.DeleteStorageConfiguration) (*device.DeleteStorageConfigurationResponse, error) {
+	var response device.DeleteStorageConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//DeleteUsers calls tds:DeleteUsers and returns its response
+func (c *DeviceClient) DeleteUsers(ctx context.Context, request device.DeleteUsers) (*device.DeleteUsersResponse, error) {
+	var response device.DeleteUsersResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetAccessPolicy calls tds:GetAccessPolicy and returns its response
+func (c *DeviceClient) GetAccessPolicy(ctx context.Context, request device.GetAccessPolicy) (*device.GetAccessPolicyResponse, error) {
+	var response device.GetAccessPolicyResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetCACertificates calls tds:GetCACertificates and returns its response
+func (c *DeviceClient) GetCACertificates(ctx context.Context, request device.GetCACertificates) (*device.GetCACertificatesResponse, error) {
+	var response device.GetCACertificatesResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetCapabilities calls tds:GetCapabilities and returns its response
+func (c *DeviceClient) GetCapabilities(ctx context.Context, request device.GetCapabilities) (*device.GetCapabilitiesResponse, error) {
+	var response device.GetCapabilitiesResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetCertificateInformation calls tds:GetCertificateInformation and returns its response
+func (c *DeviceClient) GetCertificateInformation(ctx context.Context, request device.GetCertificateInformation) (*device.GetCertificateInformationResponse, error) {
+	var response device.GetCertificateInformationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetCertificates calls tds:GetCertificates and returns its response
+func (c *DeviceClient) GetCertificates(ctx context.Context, request device.GetCertificates) (*device.GetCertificatesResponse, error) {
+	var response device.GetCertificatesResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetCertificatesStatus calls tds:GetCertificatesStatus and returns its response
+func (c *DeviceClient) GetCertificatesStatus(ctx context.Context, request device.GetCertificatesStatus) (*device.GetCertificatesStatusResponse, error) {
+	var response device.GetCertificatesStatusResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetClientCertificateMode calls tds:GetClientCertificateMode and returns its response
+func (c *DeviceClient) GetClientCertificateMode(ctx context.Context, request device.GetClientCertificateMode) (*device.GetClientCertificateModeResponse, error) {
+	var response device.GetClientCertificateModeResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetDNS calls tds:GetDNS and returns its response
+func (c *DeviceClient) GetDNS(ctx context.Context, request device.GetDNS) (*device.GetDNSResponse, error) {
+	var response device.GetDNSResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetDPAddresses calls tds:GetDPAddresses and returns its response
+func (c *DeviceClient) GetDPAddresses(ctx context.Context, request device.GetDPAddresses) (*device.GetDPAddressesResponse, error) {
+	var response device.GetDPAddressesResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetDeviceInformation calls tds:GetDeviceInformation and returns its response
+func (c *DeviceClient) GetDeviceInformation(ctx context.Context, request device.GetDeviceInformation) (*device.GetDeviceInformationResponse, error) {
+	var response device.GetDeviceInformationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetDiscoveryMode calls tds:GetDiscoveryMode and returns its response
+func (c *DeviceClient) GetDiscoveryMode(ctx context.Context, request device.GetDiscoveryMode) (*device.GetDiscoveryModeResponse, error) {
+	var response device.GetDiscoveryModeResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetDot11Capabilities calls tds:GetDot11Capabilities and returns its response
+func (c *DeviceClient) GetDot11Capabilities(ctx context.Context, request device.GetDot11Capabilities) (*device.GetDot11CapabilitiesResponse, error) {
+	var response device.GetDot11CapabilitiesResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetDot11Status calls tds:GetDot11Status and returns its response
+func (c *DeviceClient) GetDot11Status(ctx context.Context, request device.GetDot11Status) (*device.GetDot11StatusResponse, error) {
+	var response device.GetDot11StatusResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetDot1XConfiguration calls tds:GetDot1XConfiguration and returns its response
+func (c *DeviceClient) GetDot1XConfiguration(ctx context.Context, request device.GetDot1XConfiguration) (*device.GetDot1XConfigurationResponse, error) {
+	var response device.GetDot1XConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetDot1XConfigurations calls tds:GetDot1XConfigurations and returns its response
+func (c *DeviceClient) GetDot1XConfigurations(ctx context.Context, request device.GetDot1XConfigurations) (*device.GetDot1XConfigurationsResponse, error) {
+	var response device.GetDot1XConfigurationsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetDynamicDNS calls tds:GetDynamicDNS and returns its response
+func (c *DeviceClient) GetDynamicDNS(ctx context.Context, request device.GetDynamicDNS) (*device.GetDynamicDNSResponse, error) {
+	var response device.GetDynamicDNSResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetEndpointReference calls tds:GetEndpointReference and returns its response
+func (c *DeviceClient) GetEndpointReference(ctx context.Context, request device.GetEndpointReference) (*device.GetEndpointReferenceResponse, error) {
+	var response device.GetEndpointReferenceResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetGeoLocation calls tds:GetGeoLocation and returns its response
+func (c *DeviceClient) GetGeoLocation(ctx context.Context, request device.GetGeoLocation) (*device.GetGeoLocationResponse, error) {
+	var response device.GetGeoLocationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetHostname calls tds:GetHostname and returns its response
+func (c *DeviceClient) GetHostname(ctx context.Context, request device.GetHostname) (*device.GetHostnameResponse, error) {
+	var response device.GetHostnameResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetIPAddressFilter calls tds:GetIPAddressFilter and returns its response
+func (c *DeviceClient) GetIPAddressFilter(ctx context.Context, request device.GetIPAddressFilter) (*device.GetIPAddressFilterResponse, error) {
+	var response device.GetIPAddressFilterResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetNTP calls tds:GetNTP and returns its response
+func (c *DeviceClient) GetNTP(ctx context.Context, request device.GetNTP) (*device.GetNTPResponse, error) {
+	var response device.GetNTPResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetNetworkDefaultGateway calls tds:GetNetworkDefaultGateway and returns its response
+func (c *DeviceClient) GetNetworkDefaultGateway(ctx context.Context, request device.GetNetworkDefaultGateway) (*device.GetNetworkDefaultGatewayResponse, error) {
+	var response device.GetNetworkDefaultGatewayResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetNetworkInterfaces calls tds:GetNetworkInterfaces and returns its response
+func (c *DeviceClient) GetNetworkInterfaces(ctx context.Context, request device.GetNetworkInterfaces) (*device.GetNetworkInterfacesResponse, error) {
+	var response device.GetNetworkInterfacesResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetNetworkProtocols calls tds:GetNetworkProtocols and returns its response
+func (c *DeviceClient) GetNetworkProtocols(ctx context.Context, request device.GetNetworkProtocols) (*device.GetNetworkProtocolsResponse, error) {
+	var response device.GetNetworkProtocolsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetPkcs10Request calls tds:GetPkcs10Request and returns its response
+func (c *DeviceClient) GetPkcs10Request(ctx context.Context, request device.GetPkcs10Request) (*device.GetPkcs10RequestResponse, error) {
+	var response device.GetPkcs10RequestResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetRelayOutputs calls tds:GetRelayOutputs and returns its response
+func (c *DeviceClient) GetRelayOutputs(ctx context.Context, request device.GetRelayOutputs) (*device.GetRelayOutputsResponse, error) {
+	var response device.GetRelayOutputsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetRemoteDiscoveryMode calls tds:GetRemoteDiscoveryMode and returns its response
+func (c *DeviceClient) GetRemoteDiscoveryMode(ctx context.Context, request device.GetRemoteDiscoveryMode) (*device.GetRemoteDiscoveryModeResponse, error) {
+	var response device.GetRemoteDiscoveryModeResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetRemoteUser calls tds:GetRemoteUser and returns its response
+func (c *DeviceClient) GetRemoteUser(ctx context.Context, request device.GetRemoteUser) (*device.GetRemoteUserResponse, error) {
+	var response device.GetRemoteUserResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetScopes calls tds:GetScopes and returns its response
+func (c *DeviceClient) GetScopes(ctx context.Context, request device.GetScopes) (*device.GetScopesResponse, error) {
+	var response device.GetScopesResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetServiceCapabilities calls tds:GetServiceCapabilities and returns its response
+func (c *DeviceClient) GetServiceCapabilities(ctx context.Context, request device.GetServiceCapabilities) (*device.GetServiceCapabilitiesResponse, error) {
+	var response device.GetServiceCapabilitiesResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetServices calls tds:GetServices and returns its response
+func (c *DeviceClient) GetServices(ctx context.Context, request device.GetServices) (*device.GetServicesResponse, error) {
+	var response device.GetServicesResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetStorageConfiguration calls tds:GetStorageConfiguration and returns its response
+func (c *DeviceClient) GetStorageConfiguration(ctx context.Context, request device.GetStorageConfiguration) (*device.GetStorageConfigurationResponse, error) {
+	var response device.GetStorageConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetStorageConfigurations calls tds:GetStorageConfigurations and returns its response
+func (c *DeviceClient) GetStorageConfigurations(ctx context.Context, request device.GetStorageConfigurations) (*device.GetStorageConfigurationsResponse, error) {
+	var response device.GetStorageConfigurationsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetSystemBackup calls tds:GetSystemBackup and returns its response
+func (c *DeviceClient) GetSystemBackup(ctx context.Context, request device.GetSystemBackup) (*device.GetSystemBackupResponse, error) {
+	var response device.GetSystemBackupResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetSystemDateAndTime calls tds:GetSystemDateAndTime and returns its response
+func (c *DeviceClient) GetSystemDateAndTime(ctx context.Context, request device.GetSystemDateAndTime) (*device.GetSystemDateAndTimeResponse, error) {
+	var response device.GetSystemDateAndTimeResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetSystemLog calls tds:GetSystemLog and returns its response
+func (c *DeviceClient) GetSystemLog(ctx context.Context, request device.GetSystemLog) (*device.GetSystemLogResponse, error) {
+	var response device.GetSystemLogResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetSystemSupportInformation calls tds:GetSystemSupportInformation and returns its response
+func (c *DeviceClient) GetSystemSupportInformation(ctx context.Context, request device.GetSystemSupportInformation) (*device.GetSystemSupportInformationResponse, error) {
+	var response device.GetSystemSupportInformationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetSystemUris calls tds:GetSystemUris and returns its response
+func (c *DeviceClient) GetSystemUris(ctx context.Context, request device.GetSystemUris) (*device.GetSystemUrisResponse, error) {
+	var response device.GetSystemUrisResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetUsers calls tds:GetUsers and returns its response
+func (c *DeviceClient) GetUsers(ctx context.Context, request device.GetUsers) (*device.GetUsersResponse, error) {
+	var response device.GetUsersResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetWsdlUrl calls tds:GetWsdlUrl and returns its response
+func (c *DeviceClient) GetWsdlUrl(ctx context.Context, request device.GetWsdlUrl) (*device.GetWsdlUrlResponse, error) {
+	var response device.GetWsdlUrlResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetZeroConfiguration calls tds:GetZeroConfiguration and returns its response
+func (c *DeviceClient) GetZeroConfiguration(ctx context.Context, request device.GetZeroConfiguration) (*device.GetZeroConfigurationResponse, error) {
+	var response device.GetZeroConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//LoadCACertificates calls tds:LoadCACertificates and returns its response
+func (c *DeviceClient) LoadCACertificates(ctx context.Context, request device.LoadCACertificates) (*device.LoadCACertificatesResponse, error) {
+	var response device.LoadCACertificatesResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//LoadCertificateWithPrivateKey calls tds:LoadCertificateWithPrivateKey and returns its response
+func (c *DeviceClient) LoadCertificateWithPrivateKey(ctx context.Context, request device.LoadCertificateWithPrivateKey) (*device.LoadCertificateWithPrivateKeyResponse, error) {
+	var response device.LoadCertificateWithPrivateKeyResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//LoadCertificates calls tds:LoadCertificates and returns its response
+func (c *DeviceClient) LoadCertificates(ctx context.Context, request device.LoadCertificates) (*device.LoadCertificatesResponse, error) {
+	var response device.LoadCertificatesResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//RemoveIPAddressFilter calls tds:RemoveIPAddressFilter and returns its response
+func (c *DeviceClient) RemoveIPAddressFilter(ctx context.Context, request device.RemoveIPAddressFilter) (*device.RemoveIPAddressFilterResponse, error) {
+	var response device.RemoveIPAddressFilterResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//RemoveScopes calls tds:RemoveScopes and returns its response
+func (c *DeviceClient) RemoveScopes(ctx context.Context, request device.RemoveScopes) (*device.RemoveScopesResponse, error) {
+	var response device.RemoveScopesResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//RestoreSystem calls tds:RestoreSystem and returns its response
+func (c *DeviceClient) RestoreSystem(ctx context.Context, request device.RestoreSystem) (*device.RestoreSystemResponse, error) {
+	var response device.RestoreSystemResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//ScanAvailableDot11Networks calls tds:ScanAvailableDot11Networks and returns its response
+func (c *DeviceClient) ScanAvailableDot11Networks(ctx context.Context, request device.ScanAvailableDot11Networks) (*device.ScanAvailableDot11NetworksResponse, error) {
+	var response device.ScanAvailableDot11NetworksResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SendAuxiliaryCommand calls tds:SendAuxiliaryCommand and returns its response
+func (c *DeviceClient) SendAuxiliaryCommand(ctx context.Context, request device.SendAuxiliaryCommand) (*device.SendAuxiliaryCommandResponse, error) {
+	var response device.SendAuxiliaryCommandResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetAccessPolicy calls tds:SetAccessPolicy and returns its response
+func (c *DeviceClient) SetAccessPolicy(ctx context.Context, request device.SetAccessPolicy) (*device.SetAccessPolicyResponse, error) {
+	var response device.SetAccessPolicyResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetCertificatesStatus calls tds:SetCertificatesStatus and returns its response
+func (c *DeviceClient) SetCertificatesStatus(ctx context.Context, request device.SetCertificatesStatus) (*device.SetCertificatesStatusResponse, error) {
+	var response device.SetCertificatesStatusResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetClientCertificateMode calls tds:SetClientCertificateMode and returns its response
+func (c *DeviceClient) SetClientCertificateMode(ctx context.Context, request device.SetClientCertificateMode) (*device.SetClientCertificateModeResponse, error) {
+	var response device.SetClientCertificateModeResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetDNS calls tds:SetDNS and returns its response
+func (c *DeviceClient) SetDNS(ctx context.Context, request device.SetDNS) (*device.SetDNSResponse, error) {
+	var response device.SetDNSResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetDPAddresses calls tds:SetDPAddresses and returns its response
+func (c *DeviceClient) SetDPAddresses(ctx context.Context, request device.SetDPAddresses) (*device.SetDPAddressesResponse, error) {
+	var response device.SetDPAddressesResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetDiscoveryMode calls tds:SetDiscoveryMode and returns its response
+func (c *DeviceClient) SetDiscoveryMode(ctx context.Context, request device.SetDiscoveryMode) (*device.SetDiscoveryModeResponse, error) {
+	var response device.SetDiscoveryModeResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetDot1XConfiguration calls tds:SetDot1XConfiguration and returns its response
+func (c *DeviceClient) SetDot1XConfiguration(ctx context.Context, request device.SetDot1XConfiguration) (*device.SetDot1XConfigurationResponse, error) {
+	var response device.SetDot1XConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetDynamicDNS calls tds:SetDynamicDNS and returns its response
+func (c *DeviceClient) SetDynamicDNS(ctx context.Context, request device.SetDynamicDNS) (*device.SetDynamicDNSResponse, error) {
+	var response device.SetDynamicDNSResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetGeoLocation calls tds:SetGeoLocation and returns its response
+func (c *DeviceClient) SetGeoLocation(ctx context.Context, request device.SetGeoLocation) (*device.SetGeoLocationResponse, error) {
+	var response device.SetGeoLocationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetHostname calls tds:SetHostname and returns its response
+func (c *DeviceClient) SetHostname(ctx context.Context, request device.SetHostname) (*device.SetHostnameResponse, error) {
+	var response device.SetHostnameResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetHostnameFromDHCP calls tds:SetHostnameFromDHCP and returns its response
+func (c *DeviceClient) SetHostnameFromDHCP(ctx context.Context, request device.SetHostnameFromDHCP) (*device.SetHostnameFromDHCPResponse, error) {
+	var response device.SetHostnameFromDHCPResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetIPAddressFilter calls tds:SetIPAddressFilter and returns its response
+func (c *DeviceClient) SetIPAddressFilter(ctx context.Context, request device.SetIPAddressFilter) (*device.SetIPAddressFilterResponse, error) {
+	var response device.SetIPAddressFilterResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetNTP calls tds:SetNTP and returns its response
+func (c *DeviceClient) SetNTP(ctx context.Context, request device.SetNTP) (*device.SetNTPResponse, error) {
+	var response device.SetNTPResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetNetworkDefaultGateway calls tds:SetNetworkDefaultGateway and returns its response
+func (c *DeviceClient) SetNetworkDefaultGateway(ctx context.Context, request device.SetNetworkDefaultGateway) (*device.SetNetworkDefaultGatewayResponse, error) {
+	var response device.SetNetworkDefaultGatewayResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetNetworkInterfaces calls tds:SetNetworkInterfaces and returns its response
+func (c *DeviceClient) SetNetworkInterfaces(ctx context.Context, request device.SetNetworkInterfaces) (*device.SetNetworkInterfacesResponse, error) {
+	var response device.SetNetworkInterfacesResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetNetworkProtocols calls tds:SetNetworkProtocols and returns its response
+func (c *DeviceClient) SetNetworkProtocols(ctx context.Context, request device.SetNetworkProtocols) (*device.SetNetworkProtocolsResponse, error) {
+	var response device.SetNetworkProtocolsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetRelayOutputSettings calls tds:SetRelayOutputSettings and returns its response
+func (c *DeviceClient) SetRelayOutputSettings(ctx context.Context, request device.SetRelayOutputSettings) (*device.SetRelayOutputSettingsResponse, error) {
+	var response device.SetRelayOutputSettingsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetRelayOutputState calls tds:SetRelayOutputState and returns its response
+func (c *DeviceClient) SetRelayOutputState(ctx context.Context, request device.SetRelayOutputState) (*device.SetRelayOutputStateResponse, error) {
+	var response device.SetRelayOutputStateResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetRemoteDiscoveryMode calls tds:SetRemoteDiscoveryMode and returns its response
+func (c *DeviceClient) SetRemoteDiscoveryMode(ctx context.Context, request device.SetRemoteDiscoveryMode) (*device.SetRemoteDiscoveryModeResponse, error) {
+	var response device.SetRemoteDiscoveryModeResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetRemoteUser calls tds:SetRemoteUser and returns its response
+func (c *DeviceClient) SetRemoteUser(ctx context.Context, request device.SetRemoteUser) (*device.SetRemoteUserResponse, error) {
+	var response device.SetRemoteUserResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetScopes calls tds:SetScopes and returns its response
+func (c *DeviceClient) SetScopes(ctx context.Context, request device.SetScopes) (*device.SetScopesResponse, error) {
+	var response device.SetScopesResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetStorageConfiguration calls tds:SetStorageConfiguration and returns its response
+func (c *DeviceClient) SetStorageConfiguration(ctx context.Context, request device.SetStorageConfiguration) (*device.SetStorageConfigurationResponse, error) {
+	var response device.SetStorageConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetSystemDateAndTime calls tds:SetSystemDateAndTime and returns its response
+func (c *DeviceClient) SetSystemDateAndTime(ctx context.Context, request device.SetSystemDateAndTime) (*device.SetSystemDateAndTimeResponse, error) {
+	var response device.SetSystemDateAndTimeResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetSystemFactoryDefault calls tds:SetSystemFactoryDefault and returns its response
+func (c *DeviceClient) SetSystemFactoryDefault(ctx context.Context, request device.SetSystemFactoryDefault) (*device.SetSystemFactoryDefaultResponse, error) {
+	var response device.SetSystemFactoryDefaultResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetUser calls tds:SetUser and returns its response
+func (c *DeviceClient) SetUser(ctx context.Context, request device.SetUser) (*device.SetUserResponse, error) {
+	var response device.SetUserResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetZeroConfiguration calls tds:SetZeroConfiguration and returns its response
+func (c *DeviceClient) SetZeroConfiguration(ctx context.Context, request device.SetZeroConfiguration) (*device.SetZeroConfigurationResponse, error) {
+	var response device.SetZeroConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//StartFirmwareUpgrade calls tds:StartFirmwareUpgrade and returns its response
+func (c *DeviceClient) StartFirmwareUpgrade(ctx context.Context, request device.StartFirmwareUpgrade) (*device.StartFirmwareUpgradeResponse, error) {
+	var response device.StartFirmwareUpgradeResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//StartSystemRestore calls tds:StartSystemRestore and returns its response
+func (c *DeviceClient) StartSystemRestore(ctx context.Context, request device.StartSystemRestore) (*device.StartSystemRestoreResponse, error) {
+	var response device.StartSystemRestoreResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SystemReboot calls tds:SystemReboot and returns its response
+func (c *DeviceClient) SystemReboot(ctx context.Context, request device.SystemReboot) (*device.SystemRebootResponse, error) {
+	var response device.SystemRebootResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//UpgradeSystemFirmware calls tds:UpgradeSystemFirmware and returns its response
+func (c *DeviceClient) UpgradeSystemFirmware(ctx context.Context, request device.UpgradeSystemFirmware) (*device.UpgradeSystemFirmwareResponse, error) {
+	var response device.UpgradeSystemFirmwareResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
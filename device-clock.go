@@ -0,0 +1,73 @@
+package goonvif
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/use-go/goonvif/device"
+)
+
+//SyncClock reads the device UTC time by GetSystemDateAndTime and records its
+//offset to the local clock, WS-Security tokens are then created with the device time.
+//The request is sent without WS-Security header, as GetSystemDateAndTime is a pre-auth operation
+func (dev *Device) SyncClock(ctx context.Context) error {
+	endpoint, err := dev.getEndpoint("device")
+	if err != nil {
+		return err
+	}
+
+	method := device.GetSystemDateAndTime{}
+	sent := time.Now()
+	resp, err := dev.callMethodDo(ctx, endpoint, method, nil, false)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	received := time.Now()
+
+	var response systemDateAndTimeResponse
+	if err := unmarshalResponse(resp, responseTag(method), &response); err != nil {
+		return err
+	}
+	utc := response.SystemDateAndTime.UTCDateTime
+	if utc.Date.Year == 0 {
+		return errors.New("device did not report its UTC date and time")
+	}
+
+	//the device read its clock about halfway through the round trip
+	local := sent.Add(received.Sub(sent) / 2)
+	deviceTime := time.Date(utc.Date.Year, time.Month(utc.Date.Month), utc.Date.Day, utc.Time.Hour, utc.Time.Minute, utc.Time.Second, 0, time.UTC)
+	atomic.StoreInt64(&dev.clockOffset, int64(deviceTime.Sub(local)))
+	return nil
+}
+
+//systemDateAndTimeResponse the UTC time of GetSystemDateAndTimeResponse, the tt:DateTime
+//of onvif.SystemDateTime is a plain string
+type systemDateAndTimeResponse struct {
+	SystemDateAndTime struct {
+		UTCDateTime struct {
+			Time struct {
+				Hour   int
+				Minute int
+				Second int
+			}
+			Date struct {
+				Year  int
+				Month int
+				Day   int
+			}
+		}
+	}
+}
+
+//ClockOffset returns the last measured device clock minus local clock
+func (dev *Device) ClockOffset() time.Duration {
+	return time.Duration(atomic.LoadInt64(&dev.clockOffset))
+}
+
+//deviceTime returns the current time on the device clock
+func (dev *Device) deviceTime() time.Time {
+	return time.Now().Add(dev.ClockOffset())
+}
@@ -0,0 +1,121 @@
+package goonvif
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/use-go/goonvif/device"
+	"github.com/use-go/goonvif/gosoap"
+)
+
+var createdPattern = regexp.MustCompile(`<wsu:Created>([^<]+)</wsu:Created>`)
+
+//systemDateAndTime returns the GetSystemDateAndTimeResponse of a device clock at <now>
+func systemDateAndTime(now time.Time) string {
+	now = now.UTC()
+	value := func(tag string, number int) string {
+		return "<tt:" + tag + ">" + strconv.Itoa(number) + "</tt:" + tag + ">"
+	}
+	return `<tds:GetSystemDateAndTimeResponse><tds:SystemDateAndTime><tt:DateTimeType>NTP</tt:DateTimeType>
+		<tt:DaylightSavings>false</tt:DaylightSavings><tt:UTCDateTime><tt:Time>` +
+		value("Hour", now.Hour()) + value("Minute", now.Minute()) + value("Second", now.Second()) + `</tt:Time><tt:Date>` +
+		value("Year", now.Year()) + value("Month", int(now.Month())) + value("Day", now.Day()) +
+		`</tt:Date></tt:UTCDateTime></tds:SystemDateAndTime></tds:GetSystemDateAndTimeResponse>`
+}
+
+func TestSyncClock(t *testing.T) {
+
+	//the device clock is 90 minutes ahead
+	offset := 90 * time.Minute
+	created := make(chan string, 1)
+	server := mockedDevice(func(path, request string) string {
+		switch {
+		case strings.Contains(request, "GetSystemDateAndTime"):
+			if strings.Contains(request, "UsernameToken") {
+				t.Error("GetSystemDateAndTime sent with a token")
+			}
+			return systemDateAndTime(time.Now().Add(offset))
+		case strings.Contains(request, "GetHostname"):
+			if match := createdPattern.FindStringSubmatch(request); match != nil {
+				created <- match[1]
+			}
+			return `<tds:GetHostnameResponse/>`
+		}
+		return ""
+	})
+	defer server.Close()
+
+	dev, err := NewDeviceWithAuth(strings.TrimPrefix(server.URL, "http://"), "admin", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	//the device reports whole seconds
+	if skew := dev.ClockOffset() - offset; skew < -2*time.Second || skew > 2*time.Second {
+		t.Errorf("unexpected clock offset %s", dev.ClockOffset())
+	}
+
+	if _, err = dev.Device().GetHostname(context.Background(), device.GetHostname{}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case value := <-created:
+		createdTime, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if skew := createdTime.Sub(time.Now().Add(offset)); skew < -2*time.Second || skew > 2*time.Second {
+			t.Errorf("token created at %s, not on the device clock", value)
+		}
+	default:
+		t.Error("no token created")
+	}
+}
+
+func TestNotAuthorizedRetry(t *testing.T) {
+
+	var clockCalls, hostnameCalls, rejected int32
+	server := mockedDevice(func(path, request string) string {
+		switch {
+		case strings.Contains(request, "GetSystemDateAndTime"):
+			atomic.AddInt32(&clockCalls, 1)
+			return systemDateAndTime(time.Now())
+		case strings.Contains(request, "GetHostname"):
+			//the first call or all calls are rejected
+			if atomic.AddInt32(&hostnameCalls, 1) == 1 || atomic.LoadInt32(&rejected) == 1 {
+				return soapFault("ter:NotAuthorized")
+			}
+			return `<tds:GetHostnameResponse/>`
+		}
+		return ""
+	})
+	defer server.Close()
+
+	dev, err := NewDeviceWithAuth(strings.TrimPrefix(server.URL, "http://"), "admin", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	//the clock is synced again and the call retried
+	if _, err = dev.Device().GetHostname(ctx, device.GetHostname{}); err != nil {
+		t.Fatal(err)
+	}
+	if clocks, hostnames := atomic.LoadInt32(&clockCalls), atomic.LoadInt32(&hostnameCalls); clocks != 2 || hostnames != 2 {
+		t.Errorf("%d clock syncs and %d calls", clocks, hostnames)
+	}
+
+	//the retry is done once
+	atomic.StoreInt32(&rejected, 1)
+	if _, err = dev.Device().GetHostname(ctx, device.GetHostname{}); !errors.Is(err, gosoap.ErrNotAuthorized) {
+		t.Errorf("expected not authorized, got %v", err)
+	}
+	if clocks, hostnames := atomic.LoadInt32(&clockCalls), atomic.LoadInt32(&hostnameCalls); clocks != 3 || hostnames != 4 {
+		t.Errorf("%d clock syncs and %d calls", clocks, hostnames)
+	}
+}
@@ -0,0 +1,40 @@
+package goonvif
+
+import (
+	"time"
+
+	"github.com/use-go/goonvif/networking"
+)
+
+//DeviceOption configures a Device built by NewDevice or NewDeviceWithAuth
+type DeviceOption func(*Device)
+
+//WithTransport sends the requests of the device by <transport>
+//instead of networking.DefaultTransport
+func WithTransport(transport networking.Transport) DeviceOption {
+	return func(dev *Device) {
+		dev.transport = transport
+	}
+}
+
+//WithTransportConfig gives the device its own connection pool built from <config>,
+//e.g. to trust a custom CA, present a client certificate or go through a proxy
+func WithTransportConfig(config networking.TransportConfig) DeviceOption {
+	return func(dev *Device) {
+		dev.transport = networking.NewTransport(config)
+	}
+}
+
+//WithTimeout bounds every call of the device, reading the response body included
+func WithTimeout(timeout time.Duration) DeviceOption {
+	return func(dev *Device) {
+		dev.timeout = timeout
+	}
+}
+
+//WithHTTPS reaches the default service endpoints over https
+func WithHTTPS() DeviceOption {
+	return func(dev *Device) {
+		dev.scheme = "https"
+	}
+}
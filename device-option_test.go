@@ -0,0 +1,64 @@
+package goonvif
+
+import (
+	"context"
+	"crypto/x509"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/use-go/goonvif/device"
+	"github.com/use-go/goonvif/networking"
+)
+
+func TestWithTransportConfig(t *testing.T) {
+
+	server := httptest.NewTLSServer(deviceHandler(func(path, request string) string {
+		if strings.Contains(request, "GetHostname") {
+			return `<tds:GetHostnameResponse/>`
+		}
+		return ""
+	}))
+	defer server.Close()
+	xaddr := strings.TrimPrefix(server.URL, "https://")
+
+	//the self-signed certificate of the device is rejected by the system pool
+	if _, err := NewDevice(xaddr, WithHTTPS()); err == nil {
+		t.Error("untrusted device certificate accepted")
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+	dev, err := NewDevice(xaddr, WithHTTPS(), WithTransportConfig(networking.TransportConfig{RootCAs: pool}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = dev.Device().GetHostname(context.Background(), device.GetHostname{}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+
+	server := mockedDevice(func(path, request string) string {
+		if strings.Contains(request, "GetHostname") {
+			time.Sleep(300 * time.Millisecond)
+			return `<tds:GetHostnameResponse/>`
+		}
+		return ""
+	})
+	defer server.Close()
+
+	dev, err := NewDevice(strings.TrimPrefix(server.URL, "http://"), WithTimeout(100*time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	if _, err = dev.Device().GetHostname(context.Background(), device.GetHostname{}); err == nil {
+		t.Error("slow call not timed out")
+	}
+	if waited := time.Since(start); waited > 250*time.Millisecond {
+		t.Errorf("call timed out after %s", waited)
+	}
+}
@@ -0,0 +1,169 @@
+package goonvif
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/beevik/etree"
+	"github.com/use-go/goonvif/device"
+)
+
+//ServiceInfo one service announced by the device, e.g. ver20 Media at its own XAddr
+type ServiceInfo struct {
+	Namespace string
+	XAddr     string
+	//Major and Minor version of the service, 0 when discovered by GetCapabilities
+	Major int
+	Minor int
+}
+
+//capabilityNamespaces namespaces of the GetCapabilities categories,
+//used by devices not supporting GetServices
+var capabilityNamespaces = map[string]string{
+	"Analytics": "http://www.onvif.org/ver20/analytics/wsdl",
+	"Device":    "http://www.onvif.org/ver10/device/wsdl",
+	"Events":    "http://www.onvif.org/ver10/events/wsdl",
+	"Imaging":   "http://www.onvif.org/ver20/imaging/wsdl",
+	"Media":     "http://www.onvif.org/ver10/media/wsdl",
+	"PTZ":       "http://www.onvif.org/ver20/ptz/wsdl",
+	"DeviceIO":  "http://www.onvif.org/ver10/deviceIO/wsdl",
+	"Recording": "http://www.onvif.org/ver10/recording/wsdl",
+	"Search":    "http://www.onvif.org/ver10/search/wsdl",
+	"Replay":    "http://www.onvif.org/ver10/replay/wsdl",
+}
+
+//GetServiceInfo returns the service of <namespace>, e.g. http://www.onvif.org/ver20/media/wsdl
+func (dev *Device) GetServiceInfo(namespace string) (ServiceInfo, bool) {
+	service, ok := dev.services[namespace]
+	return service, ok
+}
+
+//GetServiceInfos returns all discovered services sorted by namespace
+func (dev *Device) GetServiceInfos() []ServiceInfo {
+	services := make([]ServiceInfo, 0, len(dev.services))
+	for _, service := range dev.services {
+		services = append(services, service)
+	}
+	sort.Slice(services, func(i, j int) bool {
+		return services[i].Namespace < services[j].Namespace
+	})
+	return services
+}
+
+//discoverServices registers the service endpoints by GetServices,
+//devices not supporting it are asked by GetCapabilities
+func (dev *Device) discoverServices(ctx context.Context) error {
+	response, err := dev.Device().GetServices(ctx, device.GetServices{IncludeCapability: false})
+	if err == nil && len(response.Service) > 0 {
+		for _, service := range response.Service {
+			dev.addService(ServiceInfo{
+				Namespace: strings.TrimSpace(string(service.Namespace)),
+				XAddr:     strings.TrimSpace(string(service.XAddr)),
+				Major:     service.Version.Major,
+				Minor:     service.Version.Minor,
+			})
+		}
+		return nil
+	}
+
+	resp, capErr := dev.CallMethodContext(ctx, device.GetCapabilities{Category: "All"}, nil)
+	if capErr != nil {
+		return capErr
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.New("GetCapabilities failed: " + resp.Status)
+	}
+	return dev.getSupportedServices(resp)
+}
+
+func (dev *Device) getSupportedServices(resp *http.Response) error {
+
+	doc := etree.NewDocument()
+	data, _ := ioutil.ReadAll(resp.Body)
+	if err := doc.ReadFromBytes(data); err != nil {
+		return err
+	}
+	services := doc.FindElements("./Envelope/Body/GetCapabilitiesResponse/Capabilities/*/XAddr")
+	services = append(services, doc.FindElements("./Envelope/Body/GetCapabilitiesResponse/Capabilities/Extension/*/XAddr")...)
+	for _, j := range services {
+		tag := j.Parent().Tag
+		if namespace, ok := capabilityNamespaces[tag]; ok {
+			dev.addService(ServiceInfo{Namespace: namespace, XAddr: strings.TrimSpace(j.Text())})
+			continue
+		}
+		dev.addEndpoint(tag, strings.TrimSpace(j.Text()))
+	}
+	return nil
+}
+
+//addService records <service> by its namespace and registers its endpoint by service name
+func (dev *Device) addService(service ServiceInfo) {
+	if service.Namespace == "" || service.XAddr == "" {
+		return
+	}
+	dev.services[service.Namespace] = service
+	if name := serviceName(service.Namespace); name != "" {
+		dev.addEndpoint(name, service.XAddr)
+	}
+}
+
+//serviceName returns the endpoint name of a service namespace,
+//e.g. http://www.onvif.org/ver10/events/wsdl is events, ver20 media is media2
+func serviceName(namespace string) string {
+	parts := strings.Split(strings.TrimSuffix(namespace, "/"), "/")
+	if len(parts) < 3 || parts[len(parts)-1] != "wsdl" {
+		return ""
+	}
+	name := strings.ToLower(parts[len(parts)-2])
+	if name == "media" && parts[len(parts)-3] == "ver20" {
+		name = "media2"
+	}
+	return name
+}
+
+//packageEndpoints endpoint names of the packages not named like their service
+var packageEndpoints = map[string]string{
+	"event": "events",
+}
+
+//getMethodEndpoint chooses the endpoint of <method> by the namespace of its XMLName prefix,
+//falling back to the endpoint named like its package, e.g. wsnt:Subscribe of the event package.
+//The name must match exactly, so media calls never reach the media2 endpoint
+func (dev *Device) getMethodEndpoint(method interface{}) (string, error) {
+	if tag := xmlNameTag(method); strings.Contains(tag, ":") {
+		if service, ok := dev.services[Xlmns[tag[:strings.Index(tag, ":")]]]; ok {
+			return service.XAddr, nil
+		}
+	}
+
+	pkgPath := strings.Split(reflect.TypeOf(method).PkgPath(), "/")
+	pkg := strings.ToLower(pkgPath[len(pkgPath)-1])
+	if name, ok := packageEndpoints[pkg]; ok {
+		pkg = name
+	}
+	if endpoint, ok := dev.endpoints[pkg]; ok {
+		return endpoint, nil
+	}
+	return "", errors.New("target endpoint service " + pkg + " not found")
+}
+
+//xmlNameTag returns the name in the XMLName tag of <method>, e.g. tds:GetServices,
+//or its type name if there is none
+func xmlNameTag(method interface{}) string {
+	methodType := reflect.TypeOf(method)
+	if methodType.Kind() == reflect.Ptr {
+		methodType = methodType.Elem()
+	}
+	if field, found := methodType.FieldByName("XMLName"); found {
+		if tag := strings.Split(field.Tag.Get("xml"), ","); tag[0] != "" {
+			return tag[0]
+		}
+	}
+	return methodType.Name()
+}
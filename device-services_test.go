@@ -0,0 +1,138 @@
+package goonvif_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/use-go/goonvif"
+	media "github.com/use-go/goonvif/Media"
+	"github.com/use-go/goonvif/media2"
+)
+
+const servicesEnvelope = `<SOAP-ENV:Envelope xmlns:SOAP-ENV="http://www.w3.org/2003/05/soap-envelope" xmlns:tt="http://www.onvif.org/ver10/schema"
+	xmlns:tds="http://www.onvif.org/ver10/device/wsdl" xmlns:trt="http://www.onvif.org/ver10/media/wsdl"
+	xmlns:tr2="http://www.onvif.org/ver20/media/wsdl"><SOAP-ENV:Body>%s</SOAP-ENV:Body></SOAP-ENV:Envelope>`
+
+//mockedServicesDevice announces the services of <namespaces> at /onvif/<name> by GetServices, or the Media and
+//Events capabilities by GetCapabilities when <namespaces> is empty. The paths of GetProfiles are sent on <profiles>
+func mockedServicesDevice(profiles chan<- string, namespaces map[string]string) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		request := string(data)
+		body := ""
+		switch {
+		case strings.Contains(request, "GetServices") && len(namespaces) > 0:
+			body = `<tds:GetServicesResponse>`
+			for namespace, name := range namespaces {
+				body += `<tds:Service><tds:Namespace>` + namespace + `</tds:Namespace><tds:XAddr>` + server.URL + `/onvif/` + name +
+					`</tds:XAddr><tds:Version><tt:Major>` + name[len(name)-1:] + `</tt:Major><tt:Minor>0</tt:Minor></tds:Version></tds:Service>`
+			}
+			body += `</tds:GetServicesResponse>`
+		case strings.Contains(request, "GetCapabilities"):
+			body = `<tds:GetCapabilitiesResponse><tds:Capabilities>
+				<tt:Media><tt:XAddr>` + server.URL + `/onvif/media1</tt:XAddr></tt:Media>
+				<tt:Events><tt:XAddr>` + server.URL + `/onvif/events1</tt:XAddr></tt:Events>
+				</tds:Capabilities></tds:GetCapabilitiesResponse>`
+		case strings.Contains(request, "trt:GetProfiles"):
+			profiles <- r.URL.Path
+			body = `<trt:GetProfilesResponse/>`
+		case strings.Contains(request, "tr2:GetProfiles"):
+			profiles <- r.URL.Path
+			body = `<tr2:GetProfilesResponse/>`
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(strings.Replace(servicesEnvelope, "%s", body, 1)))
+	}))
+	return server
+}
+
+func TestServiceRouting(t *testing.T) {
+
+	profiles := make(chan string, 4)
+	server := mockedServicesDevice(profiles, map[string]string{
+		"http://www.onvif.org/ver10/device/wsdl": "device1",
+		"http://www.onvif.org/ver10/media/wsdl":  "media1",
+		"http://www.onvif.org/ver20/media/wsdl":  "media2",
+	})
+	defer server.Close()
+
+	dev, err := goonvif.NewDevice(strings.TrimPrefix(server.URL, "http://"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if service, ok := dev.GetServiceInfo("http://www.onvif.org/ver20/media/wsdl"); !ok || service.Major != 2 || dev.GetEndpoint("media2") != service.XAddr {
+		t.Errorf("unexpected media2 service %+v", service)
+	}
+
+	//the ver10 and ver20 calls are routed by the namespaces of their prefixes
+	if _, err = media.NewClient(dev).GetProfiles(ctx, media.GetProfiles{}); err != nil {
+		t.Fatal(err)
+	}
+	if path := <-profiles; path != "/onvif/media1" {
+		t.Errorf("media call sent to %s", path)
+	}
+	if _, err = media2.NewClient(dev).GetProfiles(ctx, media2.GetProfiles{}); err != nil {
+		t.Fatal(err)
+	}
+	if path := <-profiles; path != "/onvif/media2" {
+		t.Errorf("media2 call sent to %s", path)
+	}
+}
+
+func TestServiceRoutingWithoutMedia(t *testing.T) {
+
+	profiles := make(chan string, 4)
+	server := mockedServicesDevice(profiles, map[string]string{
+		"http://www.onvif.org/ver10/device/wsdl": "device1",
+		"http://www.onvif.org/ver20/media/wsdl":  "media2",
+	})
+	defer server.Close()
+
+	dev, err := goonvif.NewDevice(strings.TrimPrefix(server.URL, "http://"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//no media endpoint, the media2 one is not taken instead
+	if _, err = media.NewClient(dev).GetProfiles(context.Background(), media.GetProfiles{}); err == nil {
+		t.Error("media call without media service")
+	}
+	select {
+	case path := <-profiles:
+		t.Errorf("media call sent to %s", path)
+	default:
+	}
+}
+
+func TestServiceCapabilities(t *testing.T) {
+
+	profiles := make(chan string, 4)
+	server := mockedServicesDevice(profiles, nil)
+	defer server.Close()
+
+	dev, err := goonvif.NewDevice(strings.TrimPrefix(server.URL, "http://"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if service, ok := dev.GetServiceInfo("http://www.onvif.org/ver10/media/wsdl"); !ok || service.Major != 0 {
+		t.Errorf("unexpected media service %+v", service)
+	}
+	if endpoint := dev.GetEndpoint("events"); endpoint != server.URL+"/onvif/events1" {
+		t.Errorf("unexpected events endpoint %s", endpoint)
+	}
+
+	if _, err = media.NewClient(dev).GetProfiles(context.Background(), media.GetProfiles{}); err != nil {
+		t.Fatal(err)
+	}
+	if path := <-profiles; path != "/onvif/media1" {
+		t.Errorf("media call sent to %s", path)
+	}
+}
@@ -0,0 +1,161 @@
+package consumer
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"net"
+	"net/url"
+	"sync/atomic"
+
+	"github.com/use-go/goonvif"
+	"github.com/valyala/fasthttp"
+)
+
+//Metrics counts the notification requests of an HTTPServer
+type Metrics struct {
+	//Accepted requests carrying notifications
+	Accepted uint64
+	//Malformed requests not carrying a Notify
+	Malformed uint64
+	//UnknownSubscription requests rejected as their path is not the one of a subscription
+	UnknownSubscription uint64
+	//InvalidToken requests rejected as their path has not the token of the subscription
+	InvalidToken uint64
+	//ForbiddenAddress requests rejected as they were not posted by the device of the subscription
+	ForbiddenAddress uint64
+}
+
+//metricCounters the counters of Metrics, atomic.Uint64 is aligned on 32 bit platforms too
+type metricCounters struct {
+	accepted, malformed, unknownSubscription, invalidToken, forbiddenAddress atomic.Uint64
+}
+
+//consumerAuthorization of the notifications of a subscription
+type consumerAuthorization struct {
+	token string
+	//addresses of the device, any address when empty
+	addresses []net.IP
+}
+
+//Metrics returns the request counters of the server
+func (httpServer *HTTPServer) Metrics() Metrics {
+	return Metrics{
+		Accepted:            httpServer.metrics.accepted.Load(),
+		Malformed:           httpServer.metrics.malformed.Load(),
+		UnknownSubscription: httpServer.metrics.unknownSubscription.Load(),
+		InvalidToken:        httpServer.metrics.invalidToken.Load(),
+		ForbiddenAddress:    httpServer.metrics.forbiddenAddress.Load(),
+	}
+}
+
+//addSubscription authorizes the notifications of the subscription <id> of <dev> carrying <token>
+func (httpServer *HTTPServer) addSubscription(id, token string, dev *goonvif.Device) {
+	authorization := consumerAuthorization{token: token, addresses: deviceAddresses(dev)}
+
+	httpServer.mutex.Lock()
+	defer httpServer.mutex.Unlock()
+	if httpServer.subscriptions == nil {
+		httpServer.subscriptions = make(map[string]consumerAuthorization)
+	}
+	httpServer.subscriptions[id] = authorization
+}
+
+func (httpServer *HTTPServer) removeSubscription(id string) {
+	httpServer.mutex.Lock()
+	defer httpServer.mutex.Unlock()
+	delete(httpServer.subscriptions, id)
+}
+
+//authorize checks the request against the subscription of its path when RequireToken
+//or CheckDeviceAddress are set
+func (httpServer *HTTPServer) authorize(ctx *fasthttp.RequestCtx) error {
+	if !httpServer.RequireToken && !httpServer.CheckDeviceAddress {
+		return nil
+	}
+
+	_, deviceID, token, err := getDeviceIDNameAndToken(ctx.Path())
+	httpServer.mutex.Lock()
+	authorization, found := httpServer.subscriptions[deviceID]
+	httpServer.mutex.Unlock()
+	if err != nil || !found {
+		httpServer.metrics.unknownSubscription.Add(1)
+		return errors.New("notification of unknown subscription from " + ctx.RemoteAddr().String())
+	}
+
+	if httpServer.RequireToken && subtle.ConstantTimeCompare([]byte(token), []byte(authorization.token)) != 1 {
+		httpServer.metrics.invalidToken.Add(1)
+		return errors.New("notification with invalid token from " + ctx.RemoteAddr().String())
+	}
+
+	if httpServer.CheckDeviceAddress && len(authorization.addresses) > 0 {
+		remote := ctx.RemoteIP()
+		for _, address := range authorization.addresses {
+			if address.Equal(remote) {
+				return nil
+			}
+		}
+		httpServer.metrics.forbiddenAddress.Add(1)
+		return errors.New("notification of subscription " + deviceID + " from foreign address " + remote.String())
+	}
+	return nil
+}
+
+//tlsConfig returns the configuration of HTTPS, nil for HTTP
+func (httpServer *HTTPServer) tlsConfig() (*tls.Config, error) {
+	if httpServer.TLSConfig == nil && httpServer.CertFile == "" {
+		return nil, nil
+	}
+
+	config := &tls.Config{}
+	if httpServer.TLSConfig != nil {
+		config = httpServer.TLSConfig.Clone()
+	}
+	if httpServer.CertFile != "" {
+		certificate, err := tls.LoadX509KeyPair(httpServer.CertFile, httpServer.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = append(config.Certificates, certificate)
+	}
+	if len(config.Certificates) == 0 && config.GetCertificate == nil {
+		return nil, errors.New("no certificate to serve HTTPS")
+	}
+	return config, nil
+}
+
+//newToken returns a random secret of 128 bits
+func newToken() string {
+	secret := make([]byte, 16)
+	if _, err := rand.Read(secret); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(secret)
+}
+
+//deviceAddresses resolves the hosts of the device and of its services
+func deviceAddresses(dev *goonvif.Device) []net.IP {
+	hosts := map[string]bool{}
+	if host, _, err := net.SplitHostPort(dev.GetXaddr()); err == nil {
+		hosts[host] = true
+	} else {
+		hosts[dev.GetXaddr()] = true
+	}
+	for _, service := range dev.GetServiceInfos() {
+		if serviceURL, err := url.Parse(service.XAddr); err == nil && serviceURL.Hostname() != "" {
+			hosts[serviceURL.Hostname()] = true
+		}
+	}
+
+	var addresses []net.IP
+	for host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			addresses = append(addresses, ip)
+		} else if ips, err := net.LookupIP(host); err == nil {
+			addresses = append(addresses, ips...)
+		}
+	}
+	return addresses
+}
@@ -2,12 +2,14 @@ package consumer
 
 import (
 	"bytes"
+	"context"
+	"crypto/tls"
 	"errors"
-	"flag"
+	"fmt"
 	"log"
 	"net"
-	"os"
 	"regexp"
+	"sync"
 	"time"
 
 	"github.com/use-go/goonvif/event"
@@ -15,9 +17,12 @@ import (
 	"github.com/valyala/fasthttp"
 )
 
+//DefaultAddress the server listens on when Address is empty
+const DefaultAddress = ":8080"
+
 //HTTPServer that onvif Device could post Event to
 type HTTPServer struct {
-	//local address for Consumer using
+	//local address for Consumer using, DefaultAddress when empty
 	Address string
 	//transparent response compression or not
 	Compress bool
@@ -26,41 +31,110 @@ type HTTPServer struct {
 	//as a Server end
 	server         *fasthttp.Server
 	NotifyConsumer func(string, string, *event.Notify, event.NotificationMessage) error
-}
+	//NotifyEvent is called with the decoded payload of each message, e.g. *event.MotionAlarm
+	NotifyEvent func(deviceName, deviceID string, payload interface{})
+	//States is updated with the messages of each device by its name when set
+	States *event.StateStore
+	//TLSConfig serves HTTPS when set, e.g. with the client certificates of the devices to verify
+	TLSConfig *tls.Config
+	//CertFile and KeyFile of the server certificate serve HTTPS when set
+	CertFile string
+	KeyFile  string
+	//RequireToken rejects the notifications not posted to the path of a subscription
+	//of a SubscriptionManager with its secret token
+	RequireToken bool
+	//CheckDeviceAddress rejects the notifications of a subscription of a SubscriptionManager
+	//posted by another address than the one of its device
+	CheckDeviceAddress bool
+	//called by StopSrv, e.g. to delete the subscriptions of a SubscriptionManager
+	onStop []func()
+	//subscriptions authorized by SubscriptionManagers by id
+	subscriptions map[string]consumerAuthorization
+	metrics       metricCounters
 
-// this is default configuration
-var (
-	addr     = flag.String("addr", ":8080", "TCP address to listen to")
-	compress = flag.Bool("compress", false, "Whether to enable transparent response compression")
-)
+	mutex    sync.Mutex
+	stopOnce sync.Once
+	stopped  bool
+}
 
-//StartSrv Serving in One Http Endpoint
-func (httpServer *HTTPServer) StartSrv() error {
+//Listen opens the listener on Address, Serve listens by itself when it was not called.
+//Call it to know the listen errors before serving in another goroutine
+func (httpServer *HTTPServer) Listen() error {
+	httpServer.mutex.Lock()
+	defer httpServer.mutex.Unlock()
 
+	if httpServer.listener != nil {
+		return nil
+	}
 	if httpServer.Address == "" {
-		httpServer.Address = *addr
+		httpServer.Address = DefaultAddress
 	}
-	if httpServer.Compress {
-		fasthttp.CompressHandler(httpServer.ServerRequestHandler)
-	} else {
-		httpServer.Compress = *compress
+	config, err := httpServer.tlsConfig()
+	if err != nil {
+		return err
 	}
 	listener, err := net.Listen("tcp", httpServer.Address)
 	if err != nil {
-		log.Fatal(err.Error() + " : port occupied or ip unavailable")
-		os.Exit(1)
-		return err
+		return fmt.Errorf("port occupied or ip unavailable: %w", err)
+	}
+	if config != nil {
+		listener = tls.NewListener(listener, config)
 	}
 	httpServer.listener = listener
+	return nil
+}
+
+//Serve serves the notifications until ctx is done or StopSrv is called, the server is then
+//shut down gracefully: the subscriptions are deleted, the listener closed and the requests
+//in progress answered. It returns nil after a shutdown and the error otherwise
+func (httpServer *HTTPServer) Serve(ctx context.Context) error {
+	if err := httpServer.Listen(); err != nil {
+		return err
+	}
+
+	handler := httpServer.ServerRequestHandler
+	if httpServer.Compress {
+		handler = fasthttp.CompressHandler(handler)
+	}
 	server := &fasthttp.Server{
-		Handler:      httpServer.ServerRequestHandler,
+		Handler:      handler,
 		ReadTimeout:  2 * time.Second,
 		WriteTimeout: 2 * time.Second,
 	}
+	httpServer.mutex.Lock()
+	if httpServer.stopped {
+		httpServer.mutex.Unlock()
+		return errors.New("server is stopped")
+	}
 	httpServer.server = server
-	log.Fatal(server.Serve(listener))
+	listener := httpServer.listener
+	httpServer.mutex.Unlock()
 
-	return nil
+	served := make(chan error, 1)
+	go func() {
+		served <- server.Serve(listener)
+	}()
+
+	var err error
+	select {
+	case err = <-served:
+	case <-ctx.Done():
+		httpServer.StopSrv()
+		err = <-served
+	}
+
+	httpServer.mutex.Lock()
+	defer httpServer.mutex.Unlock()
+	if httpServer.stopped {
+		//closing the listener may fail the serving
+		return nil
+	}
+	return err
+}
+
+//StartSrv serves the notifications until StopSrv is called
+func (httpServer *HTTPServer) StartSrv() error {
+	return httpServer.Serve(context.Background())
 }
 
 //checkNotificationPath by a spec url format
@@ -76,11 +150,17 @@ func checkNotificationPath(pathBuf []byte) bool {
 }
 
 func getDeviceIDAndName(pathBuf []byte) (deviceName string, deviceID string, err error) {
+	deviceName, deviceID, _, err = getDeviceIDNameAndToken(pathBuf)
+	return deviceName, deviceID, err
+}
+
+//getDeviceIDNameAndToken parses /<name>/<uuid>/<token>, the token is optional
+func getDeviceIDNameAndToken(pathBuf []byte) (deviceName string, deviceID string, token string, err error) {
 
 	if pathBuf == nil {
-		return "", "", errors.New("no elemment found and the path empty")
+		return "", "", "", errors.New("no elemment found and the path empty")
 	} else if len(pathBuf) == 1 || checkNotificationPath(pathBuf) == false {
-		return "", "", errors.New("no available name and deivice can be found in path context")
+		return "", "", "", errors.New("no available name and deivice can be found in path context")
 	}
 	//remove head
 	if pathBuf[0] == '/' {
@@ -91,17 +171,24 @@ func getDeviceIDAndName(pathBuf []byte) (deviceName string, deviceID string, err
 	if pathBuf[lenth-1] == '/' {
 		pathBuf = pathBuf[:lenth-1]
 	}
-	bytesArray := bytes.SplitN(pathBuf, []byte{'/'}, 2)
+	bytesArray := bytes.SplitN(pathBuf, []byte{'/'}, 3)
 
 	deviceName = string(bytesArray[0])
 	deviceID = string(bytesArray[1])
+	if len(bytesArray) == 3 {
+		token = string(bytesArray[2])
+	}
 
-	return deviceName, deviceID, nil
+	return deviceName, deviceID, token, nil
 }
 
 //ServerRequestHandler for Received Message
 func (httpServer *HTTPServer) ServerRequestHandler(ctx *fasthttp.RequestCtx) {
-	//TODO...
+	if err := httpServer.authorize(ctx); err != nil {
+		log.Print(err.Error())
+		ctx.Error("Forbidden", fasthttp.StatusForbidden)
+		return
+	}
 	//content := string(ctx.Request.Body())
 	notify := event.Notify{}
 	//reader := strings.NewReader(ctx.Request.Body())
@@ -109,9 +196,11 @@ func (httpServer *HTTPServer) ServerRequestHandler(ctx *fasthttp.RequestCtx) {
 	err := helper.Unmarshal(bytesReader, "wsnt:Notify", &notify)
 
 	if err != nil {
+		httpServer.metrics.malformed.Add(1)
 		log.Print("Error Message Received")
 		log.Print(bytesReader)
 	} else if len(notify.NotificationMessagesList) > 0 {
+		httpServer.metrics.accepted.Add(1)
 
 		if deviceName, deviceID, err := getDeviceIDAndName(ctx.Path()); err != nil {
 			log.Print(err.Error())
@@ -121,6 +210,16 @@ func (httpServer *HTTPServer) ServerRequestHandler(ctx *fasthttp.RequestCtx) {
 				if httpServer.NotifyConsumer != nil {
 					httpServer.NotifyConsumer(deviceName, deviceID, &notify, notificationMessageItem)
 				}
+				if httpServer.States != nil {
+					httpServer.States.Update(deviceName, notificationMessageItem)
+				}
+				if httpServer.NotifyEvent != nil {
+					if payload, err := notificationMessageItem.Decode(); err != nil {
+						log.Print(err.Error())
+					} else {
+						httpServer.NotifyEvent(deviceName, deviceID, payload)
+					}
+				}
 			}
 		}
 
@@ -152,7 +251,23 @@ func (httpServer *HTTPServer) replyStatusOK(ctx *fasthttp.RequestCtx) {
 	ctx.SetBodyString("Notify Message Received!")
 }
 
-//StopSrv HTTP Service
+//StopSrv HTTP Service, the subscriptions of its SubscriptionManagers are deleted first,
+//then the server is shut down waiting for the requests in progress
 func (httpServer *HTTPServer) StopSrv() {
-	httpServer.listener.Close()
+	httpServer.stopOnce.Do(func() {
+		for _, stop := range httpServer.onStop {
+			stop()
+		}
+
+		httpServer.mutex.Lock()
+		httpServer.stopped = true
+		server, listener := httpServer.server, httpServer.listener
+		httpServer.mutex.Unlock()
+		if server != nil {
+			server.Shutdown()
+		}
+		if listener != nil {
+			listener.Close()
+		}
+	})
 }
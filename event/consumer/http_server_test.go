@@ -1,8 +1,15 @@
 package consumer
 
 import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
 	"regexp"
+	"strings"
 	"testing"
+
+	"github.com/use-go/goonvif/event"
 )
 
 func TestGetDeviceIDAndName(t *testing.T) {
@@ -35,3 +42,56 @@ func TestHttpRequestCtxPah(t *testing.T) {
 	t.Log(result)
 
 }
+
+func TestHTTPServerServe(t *testing.T) {
+
+	payloads := make(chan interface{}, 1)
+	httpServer := &HTTPServer{
+		Address:     "127.0.0.1:0",
+		Compress:    true,
+		NotifyEvent: func(deviceName, deviceID string, payload interface{}) { payloads <- payload },
+	}
+	if err := httpServer.Listen(); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error)
+	go func() { served <- httpServer.Serve(ctx) }()
+
+	notify := `<env:Envelope xmlns:env="http://www.w3.org/2003/05/soap-envelope" xmlns:wsnt="http://docs.oasis-open.org/wsn/b-2"
+		xmlns:tt="http://www.onvif.org/ver10/schema"><env:Body><wsnt:Notify><wsnt:NotificationMessage>
+		<wsnt:Topic Dialect="http://www.onvif.org/ver10/tev/topicExpression/ConcreteSet">tns1:VideoSource/MotionAlarm</wsnt:Topic>
+		<wsnt:Message><tt:Message UtcTime="2018-04-10T15:52:16Z" PropertyOperation="Changed">
+		<tt:Source><tt:SimpleItem Name="Source" Value="VideoSourceToken"/></tt:Source>
+		<tt:Data><tt:SimpleItem Name="State" Value="true"/></tt:Data>
+		</tt:Message></wsnt:Message></wsnt:NotificationMessage></wsnt:Notify></env:Body></env:Envelope>`
+	request, _ := http.NewRequest("POST", "http://"+httpServer.listener.Addr().String()+
+		"/gate-camera/a1340608-a8a1-4495-8132-95303580e588", strings.NewReader(notify))
+	response, err := http.DefaultTransport.RoundTrip(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		t.Errorf("unexpected status %s", response.Status)
+	}
+	if alarm, ok := (<-payloads).(*event.MotionAlarm); !ok || !alarm.State {
+		t.Errorf("unexpected payload %+v", alarm)
+	}
+	if metrics := httpServer.Metrics(); metrics.Accepted != 1 || metrics.Malformed != 0 {
+		t.Errorf("unexpected metrics %+v", metrics)
+	}
+
+	//the address is occupied, the error of the listener is kept
+	occupied := &HTTPServer{Address: httpServer.listener.Addr().String()}
+	var opErr *net.OpError
+	if err := occupied.Listen(); !errors.As(err, &opErr) {
+		t.Errorf("expected listen error, got %v", err)
+	}
+
+	cancel()
+	if err := <-served; err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,179 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/satori/go.uuid"
+	"github.com/use-go/goonvif"
+	"github.com/use-go/goonvif/event"
+)
+
+//SubscriptionManager keeps the push subscriptions of the devices notifying an HTTPServer.
+//Each device posts to its own path /<name>/<uuid>/<token> with a secret token checked
+//by the server (RequireToken), the subscriptions are renewed periodically and deleted
+//when the server is stopped
+type SubscriptionManager struct {
+	//ConsumerAddress base url of the server reachable by the devices, e.g. http://192.168.13.2:8080
+	ConsumerAddress string
+	//TerminationTime lifetime of the subscriptions requested by subscribe and renew, 0 means 60s
+	TerminationTime time.Duration
+	//RetryInterval before a lost subscription is created again, 0 means 5s
+	RetryInterval time.Duration
+	//OnError is called with the errors the manager recovers from, e.g. a failed renew
+	OnError func(deviceName, deviceID string, err error)
+
+	server        *HTTPServer
+	mutex         sync.Mutex
+	subscriptions map[string]*pushSubscription
+}
+
+type pushSubscription struct {
+	dev     *goonvif.Device
+	name    string
+	id      string
+	token   string
+	filter  *event.FilterType
+	address string
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+//NewSubscriptionManager returns a manager of subscriptions notifying <server> at <consumerAddress>,
+//they are deleted by server.StopSrv
+func NewSubscriptionManager(server *HTTPServer, consumerAddress string) *SubscriptionManager {
+	manager := &SubscriptionManager{
+		ConsumerAddress: strings.TrimSuffix(consumerAddress, "/"),
+		server:          server,
+		subscriptions:   make(map[string]*pushSubscription),
+	}
+	server.onStop = append(server.onStop, manager.UnsubscribeAll)
+	return manager
+}
+
+//Subscribe subscribes <dev> to <topics>, all events when there is none.
+//Its notifications are posted to /<deviceName>/<uuid>/<token>, the returned id is the uuid
+func (manager *SubscriptionManager) Subscribe(ctx context.Context, dev *goonvif.Device, deviceName string, topics ...string) (string, error) {
+	if deviceName == "" || strings.ContainsAny(deviceName, "/ \t\r\n") {
+		return "", errors.New("device name must not be empty or contain slashes and spaces")
+	}
+
+	sub := &pushSubscription{
+		dev:   dev,
+		name:  deviceName,
+		id:    uuid.Must(uuid.NewV4()).String(),
+		token: newToken(),
+		done:  make(chan struct{}),
+	}
+	if len(topics) > 0 {
+		sub.filter = event.NewTopicFilter(topics...)
+	}
+
+	//the first notifications may arrive before Subscribe answers
+	manager.server.addSubscription(sub.id, sub.token, dev)
+	lifetime, err := manager.subscribe(ctx, sub)
+	if err != nil {
+		manager.server.removeSubscription(sub.id)
+		return "", err
+	}
+
+	keepCtx, cancel := context.WithCancel(context.Background())
+	sub.cancel = cancel
+	manager.mutex.Lock()
+	manager.subscriptions[sub.id] = sub
+	manager.mutex.Unlock()
+
+	go manager.keep(keepCtx, sub, lifetime)
+	return sub.id, nil
+}
+
+//Unsubscribe deletes the subscription of <id>
+func (manager *SubscriptionManager) Unsubscribe(id string) error {
+	manager.mutex.Lock()
+	sub, found := manager.subscriptions[id]
+	delete(manager.subscriptions, id)
+	manager.mutex.Unlock()
+
+	if !found {
+		return errors.New("subscription " + id + " not found")
+	}
+	sub.cancel()
+	<-sub.done
+	return nil
+}
+
+//UnsubscribeAll deletes the subscriptions of all devices
+func (manager *SubscriptionManager) UnsubscribeAll() {
+	manager.mutex.Lock()
+	subscriptions := manager.subscriptions
+	manager.subscriptions = make(map[string]*pushSubscription)
+	manager.mutex.Unlock()
+
+	for _, sub := range subscriptions {
+		sub.cancel()
+	}
+	for _, sub := range subscriptions {
+		<-sub.done
+	}
+}
+
+func (manager *SubscriptionManager) subscribe(ctx context.Context, sub *pushSubscription) (time.Duration, error) {
+	consumer := manager.ConsumerAddress + "/" + sub.name + "/" + sub.id + "/" + sub.token
+	address, lifetime, err := event.CreateSubscription(ctx, sub.dev, consumer, sub.filter, manager.terminationTime())
+	if err != nil {
+		return 0, err
+	}
+	sub.address = address
+	return lifetime, nil
+}
+
+//keep renews the subscription until ctx is done, then deletes it
+func (manager *SubscriptionManager) keep(ctx context.Context, sub *pushSubscription, lifetime time.Duration) {
+	defer close(sub.done)
+	defer manager.server.removeSubscription(sub.id)
+
+	for {
+		wait := lifetime - lifetime/5
+		if sub.address == "" {
+			wait = manager.retryInterval()
+		}
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			if sub.address != "" {
+				stopCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				event.DeleteSubscription(stopCtx, sub.dev, sub.address)
+				cancel()
+			}
+			return
+		}
+
+		var err error
+		if sub.address == "" {
+			lifetime, err = manager.subscribe(ctx, sub)
+		} else if lifetime, err = event.RenewSubscription(ctx, sub.dev, sub.address, manager.terminationTime()); err != nil {
+			//a subscription failing to renew is created again
+			sub.address = ""
+		}
+		if err != nil && ctx.Err() == nil && manager.OnError != nil {
+			manager.OnError(sub.name, sub.id, err)
+		}
+	}
+}
+
+func (manager *SubscriptionManager) terminationTime() time.Duration {
+	if manager.TerminationTime <= 0 {
+		return 60 * time.Second
+	}
+	return manager.TerminationTime
+}
+
+func (manager *SubscriptionManager) retryInterval() time.Duration {
+	if manager.RetryInterval <= 0 {
+		return 5 * time.Second
+	}
+	return manager.RetryInterval
+}
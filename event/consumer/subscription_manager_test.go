@@ -0,0 +1,119 @@
+package consumer
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/use-go/goonvif"
+	"github.com/valyala/fasthttp"
+)
+
+//mockedNotificationProducer serves GetServices and push subscriptions living 2 seconds
+func mockedNotificationProducer(consumers chan<- string, renews, unsubscribes *int32) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		request := string(data)
+		now := time.Now().UTC()
+		times := `<wsnt:CurrentTime>` + now.Format(time.RFC3339) + `</wsnt:CurrentTime>` +
+			`<wsnt:TerminationTime>` + now.Add(2*time.Second).Format(time.RFC3339) + `</wsnt:TerminationTime>`
+
+		var body string
+		switch {
+		case strings.Contains(request, "GetServices"):
+			body = `<tds:GetServicesResponse><tds:Service><tds:Namespace>http://www.onvif.org/ver10/events/wsdl</tds:Namespace>
+				<tds:XAddr>` + server.URL + `/onvif/events</tds:XAddr></tds:Service></tds:GetServicesResponse>`
+		case strings.Contains(request, "<wsnt:Subscribe>"):
+			consumer := request[strings.Index(request, "<wsa5:Address>")+len("<wsa5:Address>"):]
+			consumers <- consumer[:strings.Index(consumer, "<")]
+			body = `<wsnt:SubscribeResponse><wsnt:SubscriptionReference><wsa5:Address>` + server.URL +
+				`/onvif/subscription?Idx=7</wsa5:Address></wsnt:SubscriptionReference>` + times + `</wsnt:SubscribeResponse>`
+		case strings.Contains(request, "<wsnt:Renew>"):
+			atomic.AddInt32(renews, 1)
+			body = `<wsnt:RenewResponse>` + times + `</wsnt:RenewResponse>`
+		case strings.Contains(request, "<wsnt:Unsubscribe"):
+			atomic.AddInt32(unsubscribes, 1)
+			body = `<wsnt:UnsubscribeResponse/>`
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`<env:Envelope xmlns:env="http://www.w3.org/2003/05/soap-envelope" xmlns:tds="http://www.onvif.org/ver10/device/wsdl"
+			xmlns:wsnt="http://docs.oasis-open.org/wsn/b-2" xmlns:wsa5="http://www.w3.org/2005/08/addressing"><env:Body>` + body + `</env:Body></env:Envelope>`))
+	}))
+	return server
+}
+
+func TestSubscriptionManager(t *testing.T) {
+
+	var renews, unsubscribes int32
+	consumers := make(chan string, 1)
+	server := mockedNotificationProducer(consumers, &renews, &unsubscribes)
+	defer server.Close()
+
+	dev, err := goonvif.NewDevice(strings.TrimPrefix(server.URL, "http://"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	httpServer := &HTTPServer{RequireToken: true, CheckDeviceAddress: true}
+	if httpServer.listener, err = net.Listen("tcp", "127.0.0.1:0"); err != nil {
+		t.Fatal(err)
+	}
+	manager := NewSubscriptionManager(httpServer, "http://192.168.13.2:8080/")
+	manager.OnError = func(deviceName, deviceID string, err error) { t.Error(deviceName, deviceID, err) }
+
+	id, err := manager.Subscribe(context.Background(), dev, "gate-camera", "tns1:VideoSource/MotionAlarm")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	consumer := <-consumers
+	deviceName, deviceID, err := getDeviceIDAndName([]byte(strings.TrimPrefix(consumer, "http://192.168.13.2:8080")))
+	if err != nil || deviceName != "gate-camera" || deviceID != id {
+		t.Errorf("unexpected consumer address %s", consumer)
+	}
+
+	//only the device posting with the token is accepted
+	path := strings.TrimPrefix(consumer, "http://192.168.13.2:8080")
+	for _, request := range []struct {
+		path   string
+		remote string
+		status int
+	}{
+		{path, "127.0.0.1:4711", fasthttp.StatusOK},
+		{path[:strings.LastIndex(path, "/")] + "/0123456789abcdef0123456789abcdef", "127.0.0.1:4711", fasthttp.StatusForbidden},
+		{path, "192.168.13.66:4711", fasthttp.StatusForbidden},
+	} {
+		var ctx fasthttp.RequestCtx
+		var req fasthttp.Request
+		req.SetRequestURI(request.path)
+		remote, _ := net.ResolveTCPAddr("tcp", request.remote)
+		ctx.Init(&req, remote, nil)
+		httpServer.ServerRequestHandler(&ctx)
+		if ctx.Response.StatusCode() != request.status {
+			t.Errorf("%s from %s answered %d", request.path, request.remote, ctx.Response.StatusCode())
+		}
+	}
+	if metrics := httpServer.Metrics(); metrics.InvalidToken != 1 || metrics.ForbiddenAddress != 1 || metrics.Malformed != 1 {
+		t.Errorf("unexpected metrics %+v", metrics)
+	}
+
+	//the 2s subscription is renewed after 1.6s
+	time.Sleep(2 * time.Second)
+	httpServer.StopSrv()
+
+	if atomic.LoadInt32(&renews) == 0 {
+		t.Error("subscription not renewed")
+	}
+	if atomic.LoadInt32(&unsubscribes) != 1 {
+		t.Error("subscription not deleted")
+	}
+}
@@ -0,0 +1,235 @@
+package event
+
+import (
+	"encoding/xml"
+	"errors"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/use-go/goonvif"
+	"github.com/use-go/goonvif/xsd"
+)
+
+var (
+	//topicSegment one step of a topic path, a qualified name or * for any child
+	topicSegment = regexp.MustCompile(`^(\*|([A-Za-z_][\w.-]*:)?[A-Za-z_][\w.-]*)$`)
+	//namePrefix prefix of a qualified name in an XPath expression
+	namePrefix = regexp.MustCompile(`([A-Za-z_][\w.-]*):[A-Za-z_*]`)
+)
+
+//FilterBuilder composes the filter of a subscription from ConcreteSet topic expressions and
+//ItemFilter message content expressions. The namespaces of the prefixes used by the expressions
+//are declared on their elements, the prefixes of goonvif.Xlmns (tns1, tt, ...) need no binding
+type FilterBuilder struct {
+	topics     []string
+	contents   []string
+	namespaces map[string]string
+}
+
+//NewFilterBuilder returns an empty builder, a filter without expressions matches all events
+func NewFilterBuilder() *FilterBuilder {
+	return &FilterBuilder{namespaces: make(map[string]string)}
+}
+
+//Namespace binds <prefix> to <uri> for the expressions, e.g. a vendor topic namespace
+func (builder *FilterBuilder) Namespace(prefix, uri string) *FilterBuilder {
+	builder.namespaces[prefix] = uri
+	return builder
+}
+
+//Topic adds topic expressions, any of them matches, e.g. tns1:VideoSource/MotionAlarm
+func (builder *FilterBuilder) Topic(topics ...string) *FilterBuilder {
+	for _, topic := range topics {
+		builder.topics = append(builder.topics, strings.TrimSpace(topic))
+	}
+	return builder
+}
+
+//TopicTree adds the topic <root> and all topics below it, e.g. tns1:RuleEngine//.
+func (builder *FilterBuilder) TopicTree(root string) *FilterBuilder {
+	return builder.Topic(strings.TrimSuffix(strings.TrimSpace(root), "/") + "//.")
+}
+
+//MessageContent adds an ItemFilter XPath expression, all of them must match,
+//e.g. boolean(//tt:SimpleItem[@Name="Rule" and @Value="MyMotionDetectorRule"])
+func (builder *FilterBuilder) MessageContent(expression string) *FilterBuilder {
+	builder.contents = append(builder.contents, strings.TrimSpace(expression))
+	return builder
+}
+
+//SimpleItem adds a message content expression matching the messages with an item
+//of <name> having any of <values>
+func (builder *FilterBuilder) SimpleItem(name string, values ...string) *FilterBuilder {
+	conditions := make([]string, 0, len(values))
+	for _, value := range values {
+		conditions = append(conditions, "@Value="+xpathLiteral(value))
+	}
+	condition := "@Name=" + xpathLiteral(name)
+	switch len(conditions) {
+	case 0:
+	case 1:
+		condition += " and " + conditions[0]
+	default:
+		condition += " and (" + strings.Join(conditions, " or ") + ")"
+	}
+	return builder.MessageContent("boolean(//tt:SimpleItem[" + condition + "])")
+}
+
+//Build validates the expressions and returns the filter, nil when there is no expression
+func (builder *FilterBuilder) Build() (*FilterType, error) {
+	if len(builder.topics) == 0 && len(builder.contents) == 0 {
+		return nil, nil
+	}
+
+	filter := &FilterType{}
+	if len(builder.topics) > 0 {
+		prefixes := make(map[string]bool)
+		for _, topic := range builder.topics {
+			if err := validateTopic(topic, prefixes); err != nil {
+				return nil, err
+			}
+		}
+		namespaces, err := builder.bindings(prefixes)
+		if err != nil {
+			return nil, err
+		}
+		filter.TopicExpression = &TopicExpressionType{
+			Dialect:    xsd.AnyURI(ConcreteSetTopicExpressionDialect),
+			Value:      xsd.String(strings.Join(builder.topics, "|")),
+			Namespaces: namespaces,
+		}
+	}
+
+	if len(builder.contents) > 0 {
+		prefixes := make(map[string]bool)
+		for _, content := range builder.contents {
+			if err := validateContent(content, prefixes); err != nil {
+				return nil, err
+			}
+		}
+		namespaces, err := builder.bindings(prefixes)
+		if err != nil {
+			return nil, err
+		}
+		value := builder.contents[0]
+		if len(builder.contents) > 1 {
+			value = "(" + strings.Join(builder.contents, ") and (") + ")"
+		}
+		filter.MessageContent = &QueryExpressionType{
+			Dialect:    xsd.AnyURI(MessageContentFilterDialect),
+			Value:      xsd.String(value),
+			Namespaces: namespaces,
+		}
+	}
+	return filter, nil
+}
+
+//bindings returns the xmlns attributes of <prefixes> sorted by prefix
+func (builder *FilterBuilder) bindings(prefixes map[string]bool) ([]xml.Attr, error) {
+	names := make([]string, 0, len(prefixes))
+	for prefix := range prefixes {
+		names = append(names, prefix)
+	}
+	sort.Strings(names)
+
+	namespaces := make([]xml.Attr, 0, len(names))
+	for _, prefix := range names {
+		uri, found := builder.namespaces[prefix]
+		if !found {
+			uri, found = goonvif.Xlmns[prefix]
+		}
+		if !found {
+			return nil, errors.New("namespace prefix " + prefix + " is not bound")
+		}
+		namespaces = append(namespaces, xml.Attr{Name: xml.Name{Local: "xmlns:" + prefix}, Value: uri})
+	}
+	return namespaces, nil
+}
+
+//validateTopic checks a ConcreteSet topic expression and collects its prefixes
+func validateTopic(topic string, prefixes map[string]bool) error {
+	for _, alternative := range strings.Split(topic, "|") {
+		path := strings.TrimSpace(alternative)
+		if path == "" {
+			return errors.New("empty topic expression in " + topic)
+		}
+		path = strings.TrimSuffix(path, "//.")
+		for i, segment := range strings.Split(path, "/") {
+			if !topicSegment.MatchString(segment) {
+				return errors.New("invalid topic expression " + alternative)
+			}
+			colon := strings.Index(segment, ":")
+			if i == 0 && colon < 0 {
+				return errors.New("root topic of " + alternative + " has no namespace prefix")
+			}
+			if colon > 0 {
+				prefixes[segment[:colon]] = true
+			}
+		}
+	}
+	return nil
+}
+
+//validateContent checks the quotes and brackets of an XPath expression and collects its prefixes
+func validateContent(content string, prefixes map[string]bool) error {
+	if content == "" {
+		return errors.New("empty message content expression")
+	}
+
+	var unquoted strings.Builder
+	var brackets []rune
+	var quote rune
+	for _, r := range content {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+			continue
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '(' || r == '[':
+			brackets = append(brackets, r)
+		case r == ')' || r == ']':
+			open := '('
+			if r == ']' {
+				open = '['
+			}
+			if len(brackets) == 0 || brackets[len(brackets)-1] != open {
+				return errors.New("unbalanced brackets in message content " + content)
+			}
+			brackets = brackets[:len(brackets)-1]
+		}
+		unquoted.WriteRune(r)
+	}
+	if quote != 0 || len(brackets) > 0 {
+		return errors.New("unterminated message content " + content)
+	}
+
+	for _, match := range namePrefix.FindAllStringSubmatch(unquoted.String(), -1) {
+		prefixes[match[1]] = true
+	}
+	return nil
+}
+
+//xpathLiteral quotes <value> by the quote it does not contain, values with both
+//quotes are concatenated from parts, e.g. concat('say "it', "'", 's"')
+func xpathLiteral(value string) string {
+	switch {
+	case !strings.Contains(value, `"`):
+		return `"` + value + `"`
+	case !strings.Contains(value, "'"):
+		return "'" + value + "'"
+	}
+	var parts []string
+	for i, part := range strings.Split(value, "'") {
+		if i > 0 {
+			parts = append(parts, `"'"`)
+		}
+		if part != "" {
+			parts = append(parts, "'"+part+"'")
+		}
+	}
+	return "concat(" + strings.Join(parts, ", ") + ")"
+}
@@ -0,0 +1,55 @@
+package event
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+func TestFilterBuilder(t *testing.T) {
+
+	filter, err := NewFilterBuilder().
+		Namespace("tnsaxis", "http://www.axis.com/2009/event/topics").
+		TopicTree("tns1:RuleEngine").
+		Topic(VideoSourceMotionAlarmTopic, "tnsaxis:Storage/Alert").
+		SimpleItem("VideoSourceConfigurationToken", "1").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := xml.Marshal(Subscribe{Filter: filter})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		`Dialect="http://www.onvif.org/ver10/tev/topicExpression/ConcreteSet" xmlns:tns1="http://www.onvif.org/ver10/topics" xmlns:tnsaxis="http://www.axis.com/2009/event/topics">` +
+			`tns1:RuleEngine//.|tns1:VideoSource/MotionAlarm|tnsaxis:Storage/Alert</wsnt:TopicExpression>`,
+		`xmlns:tt="http://www.onvif.org/ver10/schema">boolean(//tt:SimpleItem[@Name=&#34;VideoSourceConfigurationToken&#34; and @Value=&#34;1&#34;])</wsnt:MessageContent>`,
+	} {
+		if !strings.Contains(string(data), expected) {
+			t.Errorf("%s not in %s", expected, data)
+		}
+	}
+
+	//a value with both quotes
+	filter, err = NewFilterBuilder().SimpleItem("Rule", `say "it's"`).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `boolean(//tt:SimpleItem[@Name="Rule" and @Value=concat('say "it', "'", 's"')])`; string(filter.MessageContent.Value) != expected {
+		t.Errorf("expected %s, got %s", expected, filter.MessageContent.Value)
+	}
+
+	for _, builder := range []*FilterBuilder{
+		NewFilterBuilder().Topic("VideoSource/MotionAlarm"),
+		NewFilterBuilder().Topic("tns1:VideoSource/Motion Alarm"),
+		NewFilterBuilder().Topic("tnsunknown:Storage/Alert"),
+		NewFilterBuilder().MessageContent(`boolean(//tt:SimpleItem[@Name="Rule"]`),
+		NewFilterBuilder().MessageContent(`boolean(//tt:SimpleItem[@Name="Rule])`),
+	} {
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("invalid filter %+v built", builder)
+		}
+	}
+}
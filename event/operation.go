@@ -23,9 +23,9 @@ type SubscriptionPolicy struct { //tev http://www.onvif.org/ver10/events/wsdl
 type Subscribe struct { //http://docs.oasis-open.org/wsn/b-2.xsd
 	XMLName                struct{}                   `xml:"wsnt:Subscribe"`
 	ConsumerReference      EndpointReferenceType      `xml:"wsnt:ConsumerReference"`
-	Filter                 FilterType                 `xml:"wsnt:Filter"`
-	SubscriptionPolicy     SubscriptionPolicy         `xml:"wsnt:SubscriptionPolicy"`
-	InitialTerminationTime AbsoluteOrRelativeTimeType `xml:"wsnt:InitialTerminationTime"`
+	Filter                 *FilterType                `xml:"wsnt:Filter,omitempty"`
+	SubscriptionPolicy     *SubscriptionPolicy        `xml:"wsnt:SubscriptionPolicy,omitempty"`
+	InitialTerminationTime AbsoluteOrRelativeTimeType `xml:"wsnt:InitialTerminationTime,omitempty"`
 }
 
 //SubscribeResponse message for subscribe event topic
@@ -43,8 +43,8 @@ type Renew struct { //http://docs.oasis-open.org/wsn/b-2.xsd
 
 //RenewResponse for Renew action
 type RenewResponse struct { //http://docs.oasis-open.org/wsn/b-2.xsd
-	TerminationTime TerminationTime `xml:"wsnt:TerminationTime"`
-	CurrentTime     CurrentTime     `xml:"wsnt:CurrentTime"`
+	TerminationTime TerminationTime `xml:"TerminationTime"`
+	CurrentTime     CurrentTime     `xml:"CurrentTime"`
 }
 
 //Unsubscribe action for Unsubscribe event topic
@@ -81,14 +81,14 @@ type ResumeSubscriptionResponse struct { //http://docs.oasis-open.org/wsn/b-2.xs
 //BUG(r) Bad AbsoluteOrRelativeTimeType type
 type CreatePullPointSubscription struct {
 	XMLName                string                     `xml:"tev:CreatePullPointSubscription"`
-	Filter                 FilterType                 `xml:"tev:Filter"`
-	InitialTerminationTime AbsoluteOrRelativeTimeType `xml:"wsnt:InitialTerminationTime"`
-	SubscriptionPolicy     SubscriptionPolicy         `xml:"wsnt:sSubscriptionPolicy"`
+	Filter                 *FilterType                `xml:"tev:Filter,omitempty"`
+	InitialTerminationTime AbsoluteOrRelativeTimeType `xml:"tev:InitialTerminationTime,omitempty"`
+	SubscriptionPolicy     *SubscriptionPolicy        `xml:"tev:SubscriptionPolicy,omitempty"`
 }
 
 //CreatePullPointSubscriptionResponse action
 type CreatePullPointSubscriptionResponse struct {
-	SubscriptionReference EndpointReferenceType
+	SubscriptionReference SubscriptionReference
 	CurrentTime           CurrentTime
 	TerminationTime       TerminationTime
 }
@@ -102,7 +102,7 @@ type GetEventProperties struct {
 type GetEventPropertiesResponse struct {
 	TopicNamespaceLocation          xsd.AnyURI
 	FixedTopicSet                   FixedTopicSet
-	TopicSet                        TopicSetType
+	TopicSet                        TopicSet
 	TopicExpressionDialect          []TopicExpressionDialect
 	MessageContentFilterDialect     xsd.AnyURI
 	ProducerPropertiesFilterDialect xsd.AnyURI
@@ -122,7 +122,7 @@ type PullMessages struct {
 type PullMessagesResponse struct {
 	CurrentTime         CurrentTime
 	TerminationTime     TerminationTime
-	NotificationMessage NotificationMessage
+	NotificationMessage []NotificationMessage
 }
 
 //PullMessagesFaultResponse response type
@@ -0,0 +1,209 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/use-go/goonvif"
+	"github.com/use-go/goonvif/gosoap"
+	"github.com/use-go/goonvif/xsd"
+)
+
+//PullPointSubscriber receives the events of a device by a pull point subscription.
+//It long-polls PullMessages at the subscription address, renews the subscription
+//before it terminates and recreates it after faults
+type PullPointSubscriber struct {
+	//Filter of the subscription, nil receives all events
+	Filter *FilterType
+	//TerminationTime lifetime of the subscription requested by create and renew, 0 means 60s
+	TerminationTime time.Duration
+	//PullTimeout of one PullMessages long poll, 0 means 10s, shorter when the renew is due earlier.
+	//The subscription is renewed first when less than 1s (or less when the PullTimeout or the lifetime are short)
+	//is left until the renew.
+	//The timeout of the device (WithTimeout) must be longer
+	PullTimeout time.Duration
+	//MessageLimit of one PullMessages, 0 means 100
+	MessageLimit int
+	//RetryInterval before a failed subscription is recreated, 0 means 5s
+	RetryInterval time.Duration
+	//OnError is called with the errors the subscriber recovers from, e.g. a lost subscription
+	OnError func(error)
+
+	dev     *goonvif.Device
+	address  string
+	renewAt  time.Time
+	lifetime time.Duration
+}
+
+//NewPullPointSubscriber returns a subscriber to all events of dev
+func NewPullPointSubscriber(dev *goonvif.Device) *PullPointSubscriber {
+	return &PullPointSubscriber{dev: dev}
+}
+
+//Subscribe creates the subscription and delivers the received messages on the returned channel
+//until ctx is done, the subscription is then deleted and the channel closed.
+//Call it once per subscriber
+func (sub *PullPointSubscriber) Subscribe(ctx context.Context) (<-chan NotificationMessage, error) {
+	if err := sub.create(ctx); err != nil {
+		return nil, err
+	}
+
+	messages := make(chan NotificationMessage)
+	go sub.run(ctx, messages)
+	return messages, nil
+}
+
+func (sub *PullPointSubscriber) run(ctx context.Context, messages chan<- NotificationMessage) {
+	defer close(messages)
+	defer sub.unsubscribe()
+
+	for ctx.Err() == nil {
+		var err error
+		switch {
+		case sub.address == "":
+			err = sub.create(ctx)
+		case time.Until(sub.renewAt) < sub.minPollTimeout():
+			//too little time is left for a long poll
+			err = sub.renew(ctx)
+		default:
+			var response *PullMessagesResponse
+			if response, err = sub.pull(ctx); err == nil {
+				for _, message := range response.NotificationMessage {
+					select {
+					case messages <- message:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+		if err == nil || ctx.Err() != nil {
+			continue
+		}
+
+		//the subscription is recreated after any failure
+		sub.address = ""
+		if sub.OnError != nil {
+			sub.OnError(err)
+		}
+		select {
+		case <-time.After(sub.retryInterval()):
+		case <-ctx.Done():
+		}
+	}
+}
+
+func (sub *PullPointSubscriber) create(ctx context.Context) error {
+	request := CreatePullPointSubscription{
+		Filter:                 sub.Filter,
+		InitialTerminationTime: AbsoluteOrRelativeTimeType(xsd.NewDuration(sub.terminationTime())),
+	}
+	var response CreatePullPointSubscriptionResponse
+	if err := sub.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return err
+	}
+
+	address := strings.TrimSpace(string(response.SubscriptionReference.Address))
+	if address == "" {
+		return errors.New("CreatePullPointSubscription returned no subscription address")
+	}
+	sub.address = address
+	sub.scheduleRenew(lifetime(response.CurrentTime, response.TerminationTime, sub.terminationTime()))
+	return nil
+}
+
+func (sub *PullPointSubscriber) renew(ctx context.Context) error {
+	lifetime, err := RenewSubscription(ctx, sub.dev, sub.address, sub.terminationTime())
+	if err != nil {
+		return err
+	}
+	sub.scheduleRenew(lifetime)
+	return nil
+}
+
+func (sub *PullPointSubscriber) pull(ctx context.Context) (*PullMessagesResponse, error) {
+	request := PullMessages{
+		Timeout:      xsd.NewDuration(sub.pollTimeout()),
+		MessageLimit: xsd.Int(sub.messageLimit()),
+	}
+	var response PullMessagesResponse
+	err := sub.dev.CallMethodUnmarshalTo(ctx, sub.address, request, subscriptionHeaders(sub.address, gosoap.PullMessagesActionValue), &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//unsubscribe deletes the subscription, best effort as it terminates by itself anyway
+func (sub *PullPointSubscriber) unsubscribe() {
+	if sub.address == "" {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	DeleteSubscription(ctx, sub.dev, sub.address)
+	sub.address = ""
+}
+
+//scheduleRenew plans the next renew before the subscription terminates
+func (sub *PullPointSubscriber) scheduleRenew(lifetime time.Duration) {
+	sub.lifetime = lifetime
+	sub.renewAt = time.Now().Add(lifetime - lifetime/5)
+}
+
+//pollTimeout of the next PullMessages, the long poll ends by the renew time
+//so it does not outlive the subscription, but never sends a timeout below minPollTimeout
+func (sub *PullPointSubscriber) pollTimeout() time.Duration {
+	timeout := sub.pullTimeout()
+	if untilRenew := time.Until(sub.renewAt); untilRenew < timeout {
+		timeout = untilRenew
+	}
+	if minimum := sub.minPollTimeout(); timeout < minimum {
+		timeout = minimum
+	}
+	return timeout
+}
+
+//minPollTimeout the shortest long poll, 1s or the PullTimeout or a fifth of the subscription lifetime
+//when shorter, so a renew leaves the time for a poll
+func (sub *PullPointSubscriber) minPollTimeout() time.Duration {
+	minimum := time.Second
+	if timeout := sub.pullTimeout(); timeout < minimum {
+		minimum = timeout
+	}
+	if fifth := sub.lifetime / 5; fifth > 0 && fifth < minimum {
+		minimum = fifth
+	}
+	return minimum
+}
+
+func (sub *PullPointSubscriber) terminationTime() time.Duration {
+	if sub.TerminationTime <= 0 {
+		return 60 * time.Second
+	}
+	return sub.TerminationTime
+}
+
+func (sub *PullPointSubscriber) pullTimeout() time.Duration {
+	if sub.PullTimeout <= 0 {
+		return 10 * time.Second
+	}
+	return sub.PullTimeout
+}
+
+func (sub *PullPointSubscriber) messageLimit() int {
+	if sub.MessageLimit <= 0 {
+		return 100
+	}
+	return sub.MessageLimit
+}
+
+func (sub *PullPointSubscriber) retryInterval() time.Duration {
+	if sub.RetryInterval <= 0 {
+		return 5 * time.Second
+	}
+	return sub.RetryInterval
+}
@@ -0,0 +1,209 @@
+package event
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/use-go/goonvif"
+	"github.com/use-go/goonvif/xsd"
+)
+
+const mockedEnvelope = `<?xml version="1.0" encoding="UTF-8"?>
+<env:Envelope xmlns:env="http://www.w3.org/2003/05/soap-envelope" xmlns:tev="http://www.onvif.org/ver10/events/wsdl"
+	xmlns:tds="http://www.onvif.org/ver10/device/wsdl" xmlns:wsnt="http://docs.oasis-open.org/wsn/b-2"
+	xmlns:wsa5="http://www.w3.org/2005/08/addressing" xmlns:tt="http://www.onvif.org/ver10/schema"
+	xmlns:tns1="http://www.onvif.org/ver10/topics"><env:Body>%s</env:Body></env:Envelope>`
+
+var timeoutPattern = regexp.MustCompile(`<tev:Timeout>([^<]+)</tev:Timeout>`)
+
+//pullPointCalls counts the calls of a mocked pull point subscription
+type pullPointCalls struct {
+	pulls, renews, unsubscribes int32
+	//badTimeouts counts the PullMessages with a timeout of zero or below
+	badTimeouts int32
+}
+
+//mockedPullPointDevice serves GetServices and a pull point subscription living <lifetime>
+func mockedPullPointDevice(lifetime time.Duration, calls *pullPointCalls) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		request := string(data)
+		now := time.Now().UTC()
+		times := `<wsnt:CurrentTime>` + now.Format(time.RFC3339Nano) + `</wsnt:CurrentTime>` +
+			`<wsnt:TerminationTime>` + now.Add(lifetime).Format(time.RFC3339Nano) + `</wsnt:TerminationTime>`
+
+		var body string
+		switch {
+		case strings.Contains(request, "GetServices"):
+			body = `<tds:GetServicesResponse><tds:Service><tds:Namespace>http://www.onvif.org/ver10/events/wsdl</tds:Namespace>
+				<tds:XAddr>` + server.URL + `/onvif/events</tds:XAddr><tds:Version><tt:Major>2</tt:Major><tt:Minor>60</tt:Minor></tds:Version>
+				</tds:Service></tds:GetServicesResponse>`
+		case strings.Contains(request, "CreatePullPointSubscription"):
+			body = `<tev:CreatePullPointSubscriptionResponse><tev:SubscriptionReference><wsa5:Address>` + server.URL +
+				`/onvif/subscription?Idx=1</wsa5:Address></tev:SubscriptionReference>` + times + `</tev:CreatePullPointSubscriptionResponse>`
+		case strings.Contains(request, "PullMessages"):
+			if !strings.Contains(request, "subscription?Idx=1</wsa5:To>") {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			var timeout time.Duration
+			if match := timeoutPattern.FindStringSubmatch(request); match != nil {
+				timeout, _ = xsd.ParseDuration(xsd.Duration(match[1]))
+			}
+			if timeout <= 0 {
+				atomic.AddInt32(&calls.badTimeouts, 1)
+			}
+			messages := ""
+			if atomic.AddInt32(&calls.pulls, 1) == 1 {
+				for _, state := range []string{"true", "false"} {
+					messages += `<wsnt:NotificationMessage><wsnt:Topic Dialect="http://www.onvif.org/ver10/tev/topicExpression/ConcreteSet">tns1:VideoSource/MotionAlarm</wsnt:Topic>
+						<wsnt:Message><tt:Message UtcTime="` + now.Format(time.RFC3339) + `" PropertyOperation="Changed">
+						<tt:Source><tt:SimpleItem Name="Source" Value="VideoSourceToken"/></tt:Source>
+						<tt:Data><tt:SimpleItem Name="State" Value="` + state + `"/></tt:Data>
+						</tt:Message></wsnt:Message></wsnt:NotificationMessage>`
+				}
+			} else {
+				//the long poll ends without messages
+				time.Sleep(timeout)
+			}
+			body = `<tev:PullMessagesResponse>` + times + messages + `</tev:PullMessagesResponse>`
+		case strings.Contains(request, "Renew"):
+			atomic.AddInt32(&calls.renews, 1)
+			body = `<wsnt:RenewResponse>` + times + `</wsnt:RenewResponse>`
+		case strings.Contains(request, "Unsubscribe"):
+			atomic.AddInt32(&calls.unsubscribes, 1)
+			body = `<wsnt:UnsubscribeResponse/>`
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/soap+xml; charset=utf-8")
+		w.Write([]byte(strings.Replace(mockedEnvelope, "%s", body, 1)))
+	}))
+	return server
+}
+
+//waitFor polls <condition> until it holds or <timeout> passed
+func waitFor(timeout time.Duration, condition func() bool) bool {
+	for deadline := time.Now().Add(timeout); !condition(); time.Sleep(10 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestPullPointSubscriber(t *testing.T) {
+
+	var calls pullPointCalls
+	server := mockedPullPointDevice(500*time.Millisecond, &calls)
+	defer server.Close()
+
+	dev, err := goonvif.NewDevice(strings.TrimPrefix(server.URL, "http://"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	subscriber := NewPullPointSubscriber(dev)
+	subscriber.PullTimeout = 100 * time.Millisecond
+	subscriber.OnError = func(err error) { t.Error(err) }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	messages, err := subscriber.Subscribe(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, state := range []string{"true", "false"} {
+		message := <-messages
+		if message.Topic.Value != "tns1:VideoSource/MotionAlarm" || len(message.Message.Data.SimpleItem) != 1 ||
+			string(message.Message.Data.SimpleItem[0].Value) != state {
+			t.Errorf("unexpected message %+v", message)
+		}
+	}
+
+	//the 500ms subscription is renewed after 400ms
+	renewed := waitFor(time.Second, func() bool { return atomic.LoadInt32(&calls.renews) > 0 })
+	cancel()
+	for range messages {
+	}
+
+	if !renewed {
+		t.Error("subscription not renewed")
+	}
+	if atomic.LoadInt32(&calls.unsubscribes) != 1 {
+		t.Error("subscription not deleted")
+	}
+}
+
+func TestPullPointSubscriberShortLifetime(t *testing.T) {
+
+	var calls pullPointCalls
+	server := mockedPullPointDevice(300*time.Millisecond, &calls)
+	defer server.Close()
+
+	dev, err := goonvif.NewDevice(strings.TrimPrefix(server.URL, "http://"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//the 300ms subscription of the device is shorter than the default pull timeout and the minimum poll of 1s
+	subscriber := NewPullPointSubscriber(dev)
+	subscriber.OnError = func(err error) { t.Error(err) }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	messages, err := subscriber.Subscribe(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range []string{"true", "false"} {
+		select {
+		case <-messages:
+		case <-time.After(time.Second):
+			t.Fatal("no message pulled")
+		}
+	}
+
+	//renewed every 240ms with a poll in between
+	if !waitFor(2*time.Second, func() bool { return atomic.LoadInt32(&calls.renews) >= 3 }) {
+		t.Errorf("%d renews within 2s", atomic.LoadInt32(&calls.renews))
+	}
+	if pulls, renews := atomic.LoadInt32(&calls.pulls), atomic.LoadInt32(&calls.renews); pulls < renews {
+		t.Errorf("%d pulls between %d renews", pulls, renews)
+	}
+	if count := atomic.LoadInt32(&calls.badTimeouts); count > 0 {
+		t.Errorf("%d pulls without timeout", count)
+	}
+}
+
+func TestPollTimeout(t *testing.T) {
+
+	subscriber := &PullPointSubscriber{PullTimeout: 10 * time.Second}
+	for _, test := range []struct {
+		lifetime   time.Duration
+		untilRenew time.Duration
+		expected   time.Duration
+	}{
+		{time.Minute, time.Minute, 10 * time.Second},
+		{time.Minute, 5 * time.Second, 5 * time.Second},
+		//the renew is overdue
+		{time.Minute, -time.Second, time.Second},
+		{time.Minute, 0, time.Second},
+		{2 * time.Second, 0, 400 * time.Millisecond},
+	} {
+		subscriber.lifetime = test.lifetime
+		subscriber.renewAt = time.Now().Add(test.untilRenew)
+		if timeout := subscriber.pollTimeout(); timeout < test.expected-50*time.Millisecond || timeout > test.expected {
+			t.Errorf("expected %s for %s until the renew, got %s", test.expected, test.untilRenew, timeout)
+		}
+	}
+}
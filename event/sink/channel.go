@@ -0,0 +1,38 @@
+package sink
+
+import (
+	"context"
+	"sync"
+)
+
+//ChannelSink hands the events to an in-process channel
+type ChannelSink struct {
+	events chan Event
+	once   sync.Once
+}
+
+//NewChannelSink returns a sink whose channel buffers <size> events
+func NewChannelSink(size int) *ChannelSink {
+	return &ChannelSink{events: make(chan Event, size)}
+}
+
+//Events returns the channel of the events, it is closed by Close
+func (s *ChannelSink) Events() <-chan Event {
+	return s.events
+}
+
+//Send waits until the event is received or ctx is done
+func (s *ChannelSink) Send(ctx context.Context, e Event) error {
+	select {
+	case s.events <- e:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+//Close closes the channel, call it after the last Send
+func (s *ChannelSink) Close() error {
+	s.once.Do(func() { close(s.events) })
+	return nil
+}
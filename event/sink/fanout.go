@@ -0,0 +1,153 @@
+package sink
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/use-go/goonvif/event"
+)
+
+//Fanout hands each published event to all its sinks. Every sink has a bounded queue
+//served by its own goroutine, so a slow sink neither stalls the publisher nor the other sinks
+type Fanout struct {
+	//BlockTimeout a publish waits for room in a full queue before the event is dropped for that sink,
+	//0 drops at once
+	BlockTimeout time.Duration
+	//SendTimeout of one Send, 0 means 10s
+	SendTimeout time.Duration
+	//OnError is called with the failed sends and the dropped events
+	OnError func(s Sink, e Event, err error)
+
+	queues  []*queue
+	dropped uint64
+	mutex   sync.RWMutex
+	closed  bool
+	wg      sync.WaitGroup
+}
+
+type queue struct {
+	sink   Sink
+	events chan Event
+}
+
+//ErrQueueFull is passed to OnError for the events dropped as the queue of a sink is full
+var ErrQueueFull = errors.New("sink queue is full, event dropped")
+
+//NewFanout starts delivering to <sinks>, each one buffering up to <bufferSize> events
+func NewFanout(bufferSize int, sinks ...Sink) *Fanout {
+	if bufferSize < 1 {
+		bufferSize = 1
+	}
+	fanout := &Fanout{}
+	for _, s := range sinks {
+		q := &queue{sink: s, events: make(chan Event, bufferSize)}
+		fanout.queues = append(fanout.queues, q)
+		fanout.wg.Add(1)
+		go fanout.deliver(q)
+	}
+	return fanout
+}
+
+//Publish queues <e> for all sinks, it returns false when it was dropped for any of them
+func (fanout *Fanout) Publish(e Event) bool {
+	fanout.mutex.RLock()
+	defer fanout.mutex.RUnlock()
+	if fanout.closed {
+		return false
+	}
+
+	queued := true
+	for _, q := range fanout.queues {
+		if !fanout.enqueue(q, e) {
+			queued = false
+			atomic.AddUint64(&fanout.dropped, 1)
+			if fanout.OnError != nil {
+				fanout.OnError(q.sink, e, ErrQueueFull)
+			}
+		}
+	}
+	return queued
+}
+
+func (fanout *Fanout) enqueue(q *queue, e Event) bool {
+	select {
+	case q.events <- e:
+		return true
+	default:
+	}
+	if fanout.BlockTimeout <= 0 {
+		return false
+	}
+
+	timer := time.NewTimer(fanout.BlockTimeout)
+	defer timer.Stop()
+	select {
+	case q.events <- e:
+		return true
+	case <-timer.C:
+		return false
+	}
+}
+
+//NotifyConsumer publishes the message, it has the signature of consumer.HTTPServer.NotifyConsumer
+func (fanout *Fanout) NotifyConsumer(deviceName, deviceID string, notify *event.Notify, message event.NotificationMessage) error {
+	e, err := NewEvent(deviceName, deviceID, message)
+	if err != nil {
+		return err
+	}
+	if !fanout.Publish(e) {
+		return ErrQueueFull
+	}
+	return nil
+}
+
+//Dropped returns the number of events dropped for full queues
+func (fanout *Fanout) Dropped() uint64 {
+	return atomic.LoadUint64(&fanout.dropped)
+}
+
+//Close delivers the queued events and closes the sinks
+func (fanout *Fanout) Close() error {
+	fanout.mutex.Lock()
+	if fanout.closed {
+		fanout.mutex.Unlock()
+		return nil
+	}
+	fanout.closed = true
+	for _, q := range fanout.queues {
+		close(q.events)
+	}
+	fanout.mutex.Unlock()
+
+	fanout.wg.Wait()
+	var err error
+	for _, q := range fanout.queues {
+		if closeErr := q.sink.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+func (fanout *Fanout) deliver(q *queue) {
+	defer fanout.wg.Done()
+
+	for e := range q.events {
+		ctx, cancel := context.WithTimeout(context.Background(), fanout.sendTimeout())
+		err := q.sink.Send(ctx, e)
+		cancel()
+		if err != nil && fanout.OnError != nil {
+			fanout.OnError(q.sink, e, err)
+		}
+	}
+}
+
+func (fanout *Fanout) sendTimeout() time.Duration {
+	if fanout.SendTimeout <= 0 {
+		return 10 * time.Second
+	}
+	return fanout.SendTimeout
+}
@@ -0,0 +1,82 @@
+package sink
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/use-go/goonvif/event"
+	"github.com/use-go/goonvif/xsd"
+)
+
+//blockedSink receives nothing until released
+type blockedSink struct {
+	release chan struct{}
+}
+
+func (s *blockedSink) Send(ctx context.Context, e Event) error {
+	<-s.release
+	return nil
+}
+
+func (s *blockedSink) Close() error {
+	return nil
+}
+
+func TestFanout(t *testing.T) {
+
+	var buffer bytes.Buffer
+	channel := NewChannelSink(10)
+	blocked := &blockedSink{release: make(chan struct{})}
+	fanout := NewFanout(1, channel, NewJSONSink(&buffer), blocked)
+
+	//the blocked sink takes the first event and queues the second one, the others are dropped for it
+	for i := 0; i < 4; i++ {
+		fanout.Publish(Event{Device: "gate-camera", Topic: "tns1:VideoSource/MotionAlarm", UtcTime: time.Unix(int64(i), 0)})
+		time.Sleep(10 * time.Millisecond)
+	}
+	if fanout.Dropped() != 2 {
+		t.Errorf("%d events dropped", fanout.Dropped())
+	}
+
+	close(blocked.release)
+	fanout.Close()
+
+	received := 0
+	for range channel.Events() {
+		received++
+	}
+	if received != 4 || strings.Count(buffer.String(), "\n") != 4 {
+		t.Errorf("%d events received, json %s", received, buffer.String())
+	}
+}
+
+func TestMQTTTopic(t *testing.T) {
+	s := &MQTTSink{TopicPrefix: "onvif"}
+	topic := s.MQTTTopic(Event{Device: "gate+camera", Topic: "tns1:RuleEngine/tnsaxis:Cell#Motion/Motion"})
+	if topic != "onvif/gate_camera/RuleEngine/Cell_Motion/Motion" {
+		t.Errorf("unexpected topic %s", topic)
+	}
+}
+
+func TestNewEvent(t *testing.T) {
+
+	//a device omitting the zone of UTC
+	var message event.NotificationMessage
+	message.Topic.Value = "tns1:VideoSource/MotionAlarm"
+	message.Message.UtcTime = xsd.DateTime("2018-04-10T15:52:16")
+	e, err := NewEvent("gate-camera", "", message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !e.UtcTime.Equal(time.Date(2018, 4, 10, 15, 52, 16, 0, time.UTC)) {
+		t.Errorf("unexpected time %v", e.UtcTime)
+	}
+
+	message.Message.UtcTime = xsd.DateTime("yesterday")
+	if _, err = NewEvent("gate-camera", "", message); err == nil {
+		t.Error("unparsable time accepted")
+	}
+}
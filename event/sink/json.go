@@ -0,0 +1,48 @@
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+)
+
+//JSONSink writes the events as newline-delimited JSON
+type JSONSink struct {
+	mutex   sync.Mutex
+	writer  io.Writer
+	encoder *json.Encoder
+}
+
+//NewJSONSink returns a sink writing to <writer>, e.g. os.Stdout. Close closes it when it is an io.Closer
+func NewJSONSink(writer io.Writer) *JSONSink {
+	return &JSONSink{writer: writer, encoder: json.NewEncoder(writer)}
+}
+
+//NewJSONFileSink returns a sink appending to the file at <path>
+func NewJSONFileSink(path string) (*JSONSink, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return NewJSONSink(file), nil
+}
+
+//Send writes one line of <e>
+func (s *JSONSink) Send(ctx context.Context, e Event) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.encoder.Encode(e)
+}
+
+//Close closes the writer unless it is stdout or stderr
+func (s *JSONSink) Close() error {
+	if s.writer == os.Stdout || s.writer == os.Stderr {
+		return nil
+	}
+	if closer, ok := s.writer.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
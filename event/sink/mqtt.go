@@ -0,0 +1,86 @@
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/use-go/goonvif/event"
+)
+
+//MQTTSink publishes the events as JSON to an MQTT broker, by default on
+//<TopicPrefix>/<device>/<topic path>, e.g. onvif/gate-camera/VideoSource/MotionAlarm
+type MQTTSink struct {
+	//TopicPrefix of the MQTT topics, none when empty
+	TopicPrefix string
+	//Topic maps an event to its MQTT topic instead of the default mapping
+	Topic func(e Event) string
+	//QoS of the published messages
+	QoS byte
+	//RetainProperties retains the messages of property events, so new subscribers get the current states
+	RetainProperties bool
+
+	client mqtt.Client
+	owned  bool
+}
+
+//NewMQTTSink returns a sink publishing by the connected <client>
+func NewMQTTSink(client mqtt.Client, topicPrefix string) *MQTTSink {
+	return &MQTTSink{TopicPrefix: topicPrefix, client: client}
+}
+
+//DialMQTT connects to the broker of <options>, the connection is closed by Close
+func DialMQTT(options *mqtt.ClientOptions, topicPrefix string) (*MQTTSink, error) {
+	client := mqtt.NewClient(options)
+	if token := client.Connect(); token.Wait() && token.Error() != nil {
+		return nil, token.Error()
+	}
+	s := NewMQTTSink(client, topicPrefix)
+	s.owned = true
+	return s, nil
+}
+
+//MQTTTopic returns the default MQTT topic of <e>, the ONVIF topic path without namespace
+//prefixes below the device name. The MQTT wildcards + and # are replaced by _
+func (s *MQTTSink) MQTTTopic(e Event) string {
+	replacer := strings.NewReplacer("+", "_", "#", "_", "/", "_")
+	levels := []string{replacer.Replace(e.Device)}
+	if s.TopicPrefix != "" {
+		levels = append([]string{strings.TrimSuffix(s.TopicPrefix, "/")}, levels...)
+	}
+	for _, segment := range strings.Split(event.TopicPath(e.Topic), "/") {
+		if segment != "" {
+			levels = append(levels, replacer.Replace(segment))
+		}
+	}
+	return strings.Join(levels, "/")
+}
+
+//Send publishes <e> and waits for its acknowledgement of the QoS
+func (s *MQTTSink) Send(ctx context.Context, e Event) error {
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	topic := s.MQTTTopic(e)
+	if s.Topic != nil {
+		topic = s.Topic(e)
+	}
+
+	token := s.client.Publish(topic, s.QoS, s.RetainProperties && e.PropertyOperation != "", payload)
+	select {
+	case <-token.Done():
+		return token.Error()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+//Close disconnects the client of DialMQTT, a client passed to NewMQTTSink is left connected
+func (s *MQTTSink) Close() error {
+	if s.owned {
+		s.client.Disconnect(250)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package sink
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/use-go/goonvif/event"
+)
+
+//Event a notification of a device handed to the sinks
+type Event struct {
+	Device            string            `json:"device"`
+	DeviceID          string            `json:"deviceId,omitempty"`
+	Topic             string            `json:"topic"`
+	UtcTime           time.Time         `json:"utcTime"`
+	PropertyOperation string            `json:"propertyOperation,omitempty"`
+	Source            map[string]string `json:"source,omitempty"`
+	Key               map[string]string `json:"key,omitempty"`
+	Data              map[string]string `json:"data,omitempty"`
+}
+
+//Sink delivers events to a consumer, e.g. a message broker.
+//Send is called by one goroutine of a Fanout at a time
+type Sink interface {
+	Send(ctx context.Context, e Event) error
+	Close() error
+}
+
+//NewEvent returns the event of a notification <message> of a device. The UtcTime is the device time
+//of the message, the receive time for messages without one. Unparsable times are an error
+func NewEvent(device, deviceID string, message event.NotificationMessage) (Event, error) {
+	utcTime, err := message.Time()
+	if err != nil {
+		return Event{}, err
+	}
+	if utcTime.IsZero() {
+		utcTime = time.Now().UTC()
+	}
+	return Event{
+		Device:            device,
+		DeviceID:          deviceID,
+		Topic:             strings.TrimSpace(string(message.Topic.Value)),
+		UtcTime:           utcTime,
+		PropertyOperation: string(message.Message.PropertyOperation),
+		Source:            event.ItemMap(message.Message.Source),
+		Key:               event.ItemMap(message.Message.Key),
+		Data:              event.ItemMap(message.Message.Data),
+	}, nil
+}
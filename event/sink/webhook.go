@@ -0,0 +1,63 @@
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+)
+
+//WebhookSink posts each event as JSON to a URL
+type WebhookSink struct {
+	URL string
+	//Header added to the requests, e.g. Authorization
+	Header http.Header
+	//Client of the requests, http.DefaultClient when nil
+	Client *http.Client
+}
+
+//NewWebhookSink returns a sink posting to <url>
+func NewWebhookSink(url string) *WebhookSink {
+	return &WebhookSink{URL: url, Header: make(http.Header)}
+}
+
+//Send posts <e>, answers other than 2xx are errors
+func (s *WebhookSink) Send(ctx context.Context, e Event) error {
+	body, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequest(http.MethodPost, s.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for key, values := range s.Header {
+		request.Header[key] = values
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	response, err := client.Do(request.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	io.Copy(ioutil.Discard, response.Body)
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return errors.New("webhook " + s.URL + " answered " + strconv.Itoa(response.StatusCode))
+	}
+	return nil
+}
+
+//Close does nothing, the requests are done by Send
+func (s *WebhookSink) Close() error {
+	return nil
+}
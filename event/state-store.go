@@ -0,0 +1,177 @@
+package event
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+//PropertyOperation values of the messages of property topics
+const (
+	PropertyInitialized = "Initialized"
+	PropertyChanged     = "Changed"
+	PropertyDeleted     = "Deleted"
+)
+
+//PropertyKey identifies a property, e.g. the motion alarm of one video source of a device
+type PropertyKey struct {
+	Device string
+	//Topic path without namespace prefixes, see TopicPath
+	Topic string
+	//Source items and key items of the message as sorted Name=Value pairs joined by ","
+	Source string
+}
+
+//PropertyState current value of a property
+type PropertyState struct {
+	PropertyKey
+	UtcTime time.Time
+	//Operation of the last message, Initialized or Changed
+	Operation string
+	Source    map[string]string
+	Data      map[string]string
+	//Payload decoded message of the topic, see NotificationMessage.Decode
+	Payload interface{}
+}
+
+//StateStore keeps the current value of the properties notified by devices.
+//It is fed with the messages of pull point or push subscriptions by Update,
+//messages of topics that are not properties are ignored, the zero value is an empty store
+type StateStore struct {
+	//OnChange is called after a property was initialized, changed or deleted with its previous state,
+	//nil for a new property, and its current state, nil for a deleted property
+	OnChange func(previous, current *PropertyState)
+
+	mutex  sync.RWMutex
+	states map[PropertyKey]*PropertyState
+}
+
+//NewStateStore returns an empty store
+func NewStateStore() *StateStore {
+	return &StateStore{states: make(map[PropertyKey]*PropertyState)}
+}
+
+//NewPropertyKey returns the key of the property of <topic> of <device> having <source> items,
+//e.g. NewPropertyKey("gate-camera", VideoSourceMotionAlarmTopic, map[string]string{"Source": "1"})
+func NewPropertyKey(device, topic string, source map[string]string) PropertyKey {
+	pairs := make([]string, 0, len(source))
+	for name, value := range source {
+		pairs = append(pairs, name+"="+value)
+	}
+	sort.Strings(pairs)
+	return PropertyKey{Device: device, Topic: TopicPath(topic), Source: strings.Join(pairs, ",")}
+}
+
+//Update applies a message of <device> to its property, messages older than the
+//current value of the property are dropped
+func (store *StateStore) Update(device string, message NotificationMessage) {
+	operation := strings.TrimSpace(string(message.Message.PropertyOperation))
+	if operation == "" {
+		return
+	}
+
+	source := ItemMap(message.Message.Source)
+	for name, value := range ItemMap(message.Message.Key) {
+		source[name] = value
+	}
+	key := NewPropertyKey(device, string(message.Topic.Value), source)
+	utcTime, _ := message.Time()
+	var payload interface{}
+	if operation != PropertyDeleted {
+		payload, _ = message.Decode()
+	}
+
+	var previous, current *PropertyState
+	store.mutex.Lock()
+	previous = store.states[key]
+	if previous != nil && !utcTime.IsZero() && utcTime.Before(previous.UtcTime) {
+		store.mutex.Unlock()
+		return
+	}
+	if operation == PropertyDeleted {
+		delete(store.states, key)
+	} else {
+		current = &PropertyState{
+			PropertyKey: key,
+			UtcTime:     utcTime,
+			Operation:   operation,
+			Source:      source,
+			Data:        ItemMap(message.Message.Data),
+			Payload:     payload,
+		}
+		if store.states == nil {
+			store.states = make(map[PropertyKey]*PropertyState)
+		}
+		store.states[key] = current
+	}
+	store.mutex.Unlock()
+
+	if store.OnChange != nil && (previous != nil || current != nil) {
+		store.OnChange(previous, current)
+	}
+}
+
+//Get returns the state of the property of <key>
+func (store *StateStore) Get(key PropertyKey) (*PropertyState, bool) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+
+	state, found := store.states[key]
+	return state, found
+}
+
+//IsActive tells whether a data item of the property of <topic> of <device> having <source> items is true,
+//e.g. IsActive("gate-camera", VideoSourceMotionAlarmTopic, map[string]string{"Source": "1"})
+func (store *StateStore) IsActive(device, topic string, source map[string]string) bool {
+	state, found := store.Get(NewPropertyKey(device, topic, source))
+	if !found {
+		return false
+	}
+	for _, value := range state.Data {
+		value = strings.TrimSpace(value)
+		if active, err := strconv.ParseBool(value); (err == nil && active) || strings.EqualFold(value, "active") {
+			return true
+		}
+	}
+	return false
+}
+
+//Snapshot returns the states of the properties of <device>, of all devices when it is empty,
+//sorted by device, topic and source
+func (store *StateStore) Snapshot(device string) []PropertyState {
+	store.mutex.RLock()
+	states := make([]PropertyState, 0, len(store.states))
+	for key, state := range store.states {
+		if device == "" || key.Device == device {
+			states = append(states, *state)
+		}
+	}
+	store.mutex.RUnlock()
+
+	sort.Slice(states, func(i, j int) bool {
+		a, b := states[i].PropertyKey, states[j].PropertyKey
+		if a.Device != b.Device {
+			return a.Device < b.Device
+		}
+		if a.Topic != b.Topic {
+			return a.Topic < b.Topic
+		}
+		return a.Source < b.Source
+	})
+	return states
+}
+
+//Forget deletes the properties of <device>, e.g. before it initializes them again
+//for a new subscription. OnChange is not called
+func (store *StateStore) Forget(device string) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	for key := range store.states {
+		if key.Device == device {
+			delete(store.states, key)
+		}
+	}
+}
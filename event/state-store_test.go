@@ -0,0 +1,53 @@
+package event
+
+import (
+	"testing"
+
+	"github.com/use-go/goonvif/xsd"
+	"github.com/use-go/goonvif/xsd/onvif"
+)
+
+func motionMessage(utcTime, operation, state string) NotificationMessage {
+	var message NotificationMessage
+	message.Topic.Value = "tns1:VideoSource/MotionAlarm"
+	message.Message.UtcTime = xsd.DateTime(utcTime)
+	message.Message.PropertyOperation = onvif.PropertyOperationType(operation)
+	message.Message.Source.SimpleItem = []onvif.SimpleItem{{Name: "Source", Value: "1"}}
+	message.Message.Data.SimpleItem = []onvif.SimpleItem{{Name: "State", Value: xsd.AnySimpleType(state)}}
+	return message
+}
+
+func TestStateStore(t *testing.T) {
+
+	var changes int
+	store := NewStateStore()
+	store.OnChange = func(previous, current *PropertyState) { changes++ }
+	source := map[string]string{"Source": "1"}
+
+	store.Update("gate-camera", motionMessage("2018-04-10T15:52:16Z", PropertyInitialized, "false"))
+	store.Update("gate-camera", motionMessage("2018-04-10T15:52:18Z", PropertyChanged, "true"))
+	if !store.IsActive("gate-camera", "ns2:VideoSource/MotionAlarm", source) {
+		t.Error("motion not active")
+	}
+
+	//a late message does not override the current state
+	store.Update("gate-camera", motionMessage("2018-04-10T15:52:17Z", PropertyChanged, "false"))
+	state, found := store.Get(NewPropertyKey("gate-camera", VideoSourceMotionAlarmTopic, source))
+	if !found || state.Data["State"] != "true" || !state.Payload.(*MotionAlarm).State {
+		t.Errorf("unexpected state %+v", state)
+	}
+
+	store.Update("gate-camera", motionMessage("2018-04-10T15:52:19Z", PropertyDeleted, "true"))
+	if len(store.Snapshot("")) != 0 || changes != 3 {
+		t.Errorf("unexpected snapshot %+v after %d changes", store.Snapshot(""), changes)
+	}
+}
+
+func TestZeroStateStore(t *testing.T) {
+
+	var store StateStore
+	store.Update("gate-camera", motionMessage("2018-04-10T15:52:18Z", PropertyChanged, "true"))
+	if !store.IsActive("gate-camera", VideoSourceMotionAlarmTopic, map[string]string{"Source": "1"}) {
+		t.Error("motion not active")
+	}
+}
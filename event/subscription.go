@@ -0,0 +1,76 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/use-go/goonvif"
+	"github.com/use-go/goonvif/gosoap"
+	"github.com/use-go/goonvif/xsd"
+)
+
+//NewTopicFilter returns a filter matching any of <topics> by the ConcreteSet dialect,
+//e.g. NewTopicFilter(VideoSourceMotionAlarmTopic, DeviceTriggerDigitalInputTopic)
+func NewTopicFilter(topics ...string) *FilterType {
+	return &FilterType{TopicExpression: &TopicExpressionType{
+		Dialect: xsd.AnyURI(ConcreteSetTopicExpressionDialect),
+		Value:   xsd.String(strings.Join(topics, "|")),
+	}}
+}
+
+//CreateSubscription subscribes the consumer at <consumerAddress> to the notifications of <dev>
+//matching <filter> (nil means all), it returns the address and the lifetime of the subscription
+func CreateSubscription(ctx context.Context, dev *goonvif.Device, consumerAddress string, filter *FilterType, terminationTime time.Duration) (string, time.Duration, error) {
+	request := Subscribe{
+		ConsumerReference:      EndpointReferenceType{Address: AttributedURIType(consumerAddress)},
+		Filter:                 filter,
+		InitialTerminationTime: AbsoluteOrRelativeTimeType(xsd.NewDuration(terminationTime)),
+	}
+	var response SubscribeResponse
+	err := dev.CallMethodUnmarshalTo(ctx, "", request, map[string]string{"action": gosoap.SubscribeActionValue}, &response)
+	if err != nil {
+		return "", 0, err
+	}
+
+	address := strings.TrimSpace(string(response.SubscriptionReference.Address))
+	if address == "" {
+		return "", 0, errors.New("Subscribe returned no subscription address")
+	}
+	return address, lifetime(response.CurrentTime, response.TerminationTime, terminationTime), nil
+}
+
+//RenewSubscription extends the subscription at <address> by <terminationTime>,
+//it returns the lifetime of the subscription reported by the device
+func RenewSubscription(ctx context.Context, dev *goonvif.Device, address string, terminationTime time.Duration) (time.Duration, error) {
+	request := Renew{TerminationTime: AbsoluteOrRelativeTimeType(xsd.NewDuration(terminationTime))}
+	var response RenewResponse
+	err := dev.CallMethodUnmarshalTo(ctx, address, request, subscriptionHeaders(address, gosoap.RenewRequestActionValue), &response)
+	if err != nil {
+		return 0, err
+	}
+	return lifetime(response.CurrentTime, response.TerminationTime, terminationTime), nil
+}
+
+//DeleteSubscription unsubscribes the subscription at <address>
+func DeleteSubscription(ctx context.Context, dev *goonvif.Device, address string) error {
+	var response UnsubscribeResponse
+	return dev.CallMethodUnmarshalTo(ctx, address, Unsubscribe{}, subscriptionHeaders(address, gosoap.UnsubscribeActionValue), &response)
+}
+
+//subscriptionHeaders addresses a request to the subscription at <address> by WS-Addressing
+func subscriptionHeaders(address, action string) map[string]string {
+	return map[string]string{"to": address, "action": action}
+}
+
+//lifetime of a subscription taken from the device clock, so the local clock does not matter,
+//<requested> when the device times are not understood
+func lifetime(current CurrentTime, termination TerminationTime, requested time.Duration) time.Duration {
+	currentTime, err1 := xsd.ParseDateTime(string(current))
+	terminationTime, err2 := xsd.ParseDateTime(string(termination))
+	if err1 != nil || err2 != nil || !terminationTime.After(currentTime) {
+		return requested
+	}
+	return terminationTime.Sub(currentTime)
+}
@@ -0,0 +1,160 @@
+package event
+
+import "time"
+
+//Typed payloads of the notification topics, see RegisterTopic.
+//Fields tagged source, key or data take the SimpleItem of that name from the Source, Key
+//or Data item list of the message, alternative names are separated by "|".
+//The untagged fields Topic, UtcTime and PropertyOperation take the ones of the message
+
+//MotionAlarm payload of tns1:VideoSource/MotionAlarm
+type MotionAlarm struct {
+	Topic             string
+	UtcTime           time.Time
+	PropertyOperation string
+	Source            string `source:"Source|VideoSourceToken|VideoSourceConfigurationToken"`
+	State             bool   `data:"State"`
+}
+
+//SignalLoss payload of tns1:VideoSource/SignalLoss
+type SignalLoss struct {
+	Topic             string
+	UtcTime           time.Time
+	PropertyOperation string
+	Source            string `source:"Source|VideoSourceToken"`
+	State             bool   `data:"State"`
+}
+
+//ImageAlarm payload of the image quality topics of a video source, e.g.
+//tns1:VideoSource/ImageTooDark/ImagingService, the kind is told by Topic
+type ImageAlarm struct {
+	Topic             string
+	UtcTime           time.Time
+	PropertyOperation string
+	Source            string `source:"Source|VideoSourceToken"`
+	State             bool   `data:"State"`
+}
+
+//DigitalInput payload of tns1:Device/Trigger/DigitalInput
+type DigitalInput struct {
+	Topic             string
+	UtcTime           time.Time
+	PropertyOperation string
+	InputToken        string `source:"InputToken|DigitalInputToken|Index"`
+	LogicalState      bool   `data:"LogicalState|State|Level"`
+}
+
+//Relay payload of tns1:Device/Trigger/Relay, LogicalState is active or inactive
+type Relay struct {
+	Topic             string
+	UtcTime           time.Time
+	PropertyOperation string
+	RelayToken        string `source:"RelayToken|RelayOutputToken"`
+	LogicalState      string `data:"LogicalState|State"`
+}
+
+//CellMotion payload of tns1:RuleEngine/CellMotionDetector/Motion
+type CellMotion struct {
+	Topic                            string
+	UtcTime                          time.Time
+	PropertyOperation                string
+	VideoSourceConfigurationToken    string `source:"VideoSourceConfigurationToken"`
+	VideoAnalyticsConfigurationToken string `source:"VideoAnalyticsConfigurationToken"`
+	Rule                             string `source:"Rule"`
+	IsMotion                         bool   `data:"IsMotion"`
+}
+
+//LineCrossed payload of tns1:RuleEngine/LineDetector/Crossed
+type LineCrossed struct {
+	Topic                            string
+	UtcTime                          time.Time
+	PropertyOperation                string
+	VideoSourceConfigurationToken    string `source:"VideoSourceConfigurationToken"`
+	VideoAnalyticsConfigurationToken string `source:"VideoAnalyticsConfigurationToken"`
+	Rule                             string `source:"Rule"`
+	ObjectID                         string `data:"ObjectId"`
+}
+
+//FieldDetector payload of the tns1:RuleEngine/FieldDetector topics
+type FieldDetector struct {
+	Topic                            string
+	UtcTime                          time.Time
+	PropertyOperation                string
+	VideoSourceConfigurationToken    string `source:"VideoSourceConfigurationToken"`
+	VideoAnalyticsConfigurationToken string `source:"VideoAnalyticsConfigurationToken"`
+	Rule                             string `source:"Rule"`
+	ObjectID                         string `key:"ObjectId"`
+	IsInside                         bool   `data:"IsInside|State"`
+}
+
+//Counter payload of tns1:RuleEngine/CountAggregation/Counter
+type Counter struct {
+	Topic                            string
+	UtcTime                          time.Time
+	PropertyOperation                string
+	VideoSourceConfigurationToken    string `source:"VideoSourceConfigurationToken"`
+	VideoAnalyticsConfigurationToken string `source:"VideoAnalyticsConfigurationToken"`
+	Rule                             string `source:"Rule"`
+	Count                            int    `data:"Count"`
+}
+
+//Tamper payload of tns1:RuleEngine/TamperDetector/Tamper
+type Tamper struct {
+	Topic                            string
+	UtcTime                          time.Time
+	PropertyOperation                string
+	VideoSourceConfigurationToken    string `source:"VideoSourceConfigurationToken"`
+	VideoAnalyticsConfigurationToken string `source:"VideoAnalyticsConfigurationToken"`
+	Rule                             string `source:"Rule"`
+	IsTamper                         bool   `data:"IsTamper"`
+}
+
+//FireDetector payload of tns1:RuleEngine/FireDetector
+type FireDetector struct {
+	Topic                            string
+	UtcTime                          time.Time
+	PropertyOperation                string
+	VideoSourceConfigurationToken    string `source:"VideoSourceConfigurationToken|Source"`
+	VideoAnalyticsConfigurationToken string `source:"VideoAnalyticsConfigurationToken"`
+	Rule                             string `source:"Rule"`
+	State                            bool   `data:"State|IsFire"`
+}
+
+//PlateDetector payload of tns1:RuleEngine/PlateDetector
+type PlateDetector struct {
+	Topic                            string
+	UtcTime                          time.Time
+	PropertyOperation                string
+	VideoSourceConfigurationToken    string `source:"VideoSourceConfigurationToken|Source"`
+	VideoAnalyticsConfigurationToken string `source:"VideoAnalyticsConfigurationToken"`
+	Rule                             string `source:"Rule"`
+	PlateNumber                      string `data:"PlateNumber|Plate|LicensePlate"`
+	Country                          string `data:"Country|CountryCode"`
+}
+
+//ProfileChanged payload of tns1:Media/ProfileChanged
+type ProfileChanged struct {
+	Topic             string
+	UtcTime           time.Time
+	PropertyOperation string
+	Token             string `source:"Token" data:"Token"`
+}
+
+//ConfigurationChanged payload of tns1:Media/ConfigurationChanged
+type ConfigurationChanged struct {
+	Topic             string
+	UtcTime           time.Time
+	PropertyOperation string
+	Token             string `source:"Token"`
+	Type              string `source:"Type"`
+}
+
+//Notification payload of the topics without a registered type, its items by name
+type Notification struct {
+	Topic             string
+	UtcTime           time.Time
+	PropertyOperation string
+	Source            map[string]string
+	Key               map[string]string
+	Data              map[string]string
+}
@@ -0,0 +1,193 @@
+package event
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/use-go/goonvif/xsd"
+	"github.com/use-go/goonvif/xsd/onvif"
+)
+
+var (
+	topicMutex sync.RWMutex
+	//topicTypes payload struct types by topic path without namespace prefixes
+	topicTypes = map[string]reflect.Type{}
+)
+
+func init() {
+	RegisterTopic("tns1:VideoSource/MotionAlarm", MotionAlarm{})
+	RegisterTopic("tns1:VideoSource/SignalLoss", SignalLoss{})
+	for _, alarm := range []string{"ImageTooBlurry", "ImageTooDark", "ImageTooBright", "GlobalSceneChange"} {
+		for _, service := range []string{"AnalyticsService", "ImagingService", "RecordingService"} {
+			RegisterTopic("tns1:VideoSource/"+alarm+"/"+service, ImageAlarm{})
+		}
+	}
+	RegisterTopic(DeviceTriggerDigitalInputTopic, DigitalInput{})
+	RegisterTopic("tns1:Device/Trigger/Relay", Relay{})
+	RegisterTopic(DeviceTriggerDigitalRelayTopic, Relay{})
+	RegisterTopic(RuleEngineCellMotionDetectorMTopic, CellMotion{})
+	RegisterTopic(RuleEngineLineDetectorCrossedTopic, LineCrossed{})
+	for _, topic := range []string{RuleEngineFieldDetectorOInTopic, RuleEngineFieldDetectorOOutTopic,
+		RuleEngineFieldDetectorOInOrOutTopic, RuleEngineFieldDetectorOLTopic} {
+		RegisterTopic(topic, FieldDetector{})
+	}
+	RegisterTopic(RuleEngineCountAggregationCTopic, Counter{})
+	RegisterTopic("tns1:RuleEngine/TamperDetector/Tamper", Tamper{})
+	RegisterTopic(RuleEngineFireDetectorTopic, FireDetector{})
+	RegisterTopic(RuleEnginePlateDetectorTopic, PlateDetector{})
+	RegisterTopic(MediaProfileChangedTopic, ProfileChanged{})
+	RegisterTopic(MediaConfigurationChangedTopic, ConfigurationChanged{})
+}
+
+//TopicPath returns <topic> without the namespace prefixes the device picked,
+//e.g. tns1:VideoSource/MotionAlarm and ns2:VideoSource/MotionAlarm are both VideoSource/MotionAlarm
+func TopicPath(topic string) string {
+	segments := strings.Split(strings.TrimSpace(topic), "/")
+	for i, segment := range segments {
+		if colon := strings.LastIndex(segment, ":"); colon >= 0 {
+			segments[i] = segment[colon+1:]
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+//RegisterTopic decodes the notifications of <topic> into new values of the struct type of <payload>,
+//e.g. RegisterTopic("tnsaxis:Storage/Alert", StorageAlert{}) for a vendor topic.
+//It replaces the type registered before for the topic
+func RegisterTopic(topic string, payload interface{}) {
+	payloadType := reflect.TypeOf(payload)
+	if payloadType.Kind() == reflect.Ptr {
+		payloadType = payloadType.Elem()
+	}
+	if payloadType.Kind() != reflect.Struct {
+		panic("event: payload of topic " + topic + " is not a struct")
+	}
+
+	topicMutex.Lock()
+	topicTypes[TopicPath(topic)] = payloadType
+	topicMutex.Unlock()
+}
+
+//Time returns the UtcTime of the message by the device clock, zero when the message has none
+func (message NotificationMessage) Time() (time.Time, error) {
+	if strings.TrimSpace(string(message.Message.UtcTime)) == "" {
+		return time.Time{}, nil
+	}
+	return xsd.ParseDateTime(string(message.Message.UtcTime))
+}
+
+//Decode returns the payload of the notification, a pointer to the type registered for its topic,
+//e.g. *MotionAlarm, or a *Notification for the topics without a type
+func (message NotificationMessage) Decode() (interface{}, error) {
+	topic := strings.TrimSpace(string(message.Topic.Value))
+	utcTime, err := message.Time()
+	if err != nil {
+		return nil, err
+	}
+
+	topicMutex.RLock()
+	payloadType, found := topicTypes[TopicPath(topic)]
+	topicMutex.RUnlock()
+
+	if !found {
+		return &Notification{
+			Topic:             topic,
+			UtcTime:           utcTime,
+			PropertyOperation: string(message.Message.PropertyOperation),
+			Source:            ItemMap(message.Message.Source),
+			Key:               ItemMap(message.Message.Key),
+			Data:              ItemMap(message.Message.Data),
+		}, nil
+	}
+
+	payload := reflect.New(payloadType)
+	value := payload.Elem()
+	for i := 0; i < payloadType.NumField(); i++ {
+		field := payloadType.Field(i)
+		var text string
+		switch {
+		case field.Name == "Topic" && field.Type.Kind() == reflect.String:
+			value.Field(i).SetString(topic)
+			continue
+		case field.Name == "PropertyOperation" && field.Type.Kind() == reflect.String:
+			value.Field(i).SetString(string(message.Message.PropertyOperation))
+			continue
+		case field.Name == "UtcTime" && field.Type == reflect.TypeOf(time.Time{}):
+			value.Field(i).Set(reflect.ValueOf(utcTime))
+			continue
+		case itemValue(message.Message.Source, field.Tag.Get("source"), &text):
+		case itemValue(message.Message.Key, field.Tag.Get("key"), &text):
+		case itemValue(message.Message.Data, field.Tag.Get("data"), &text):
+		default:
+			continue
+		}
+		if err := setItemValue(value.Field(i), text); err != nil {
+			return nil, errors.New("topic " + topic + " item " + field.Name + ": " + err.Error())
+		}
+	}
+	return payload.Interface(), nil
+}
+
+//itemValue looks up the SimpleItem of one of the "|" separated <names>
+func itemValue(items onvif.ItemList, names string, value *string) bool {
+	if names == "" {
+		return false
+	}
+	for _, name := range strings.Split(names, "|") {
+		for _, item := range items.SimpleItem {
+			if item.Name == name {
+				*value = string(item.Value)
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func setItemValue(field reflect.Value, text string) error {
+	text = strings.TrimSpace(text)
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(text)
+	case reflect.Bool:
+		value, err := strconv.ParseBool(text)
+		if err != nil {
+			return err
+		}
+		field.SetBool(value)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetInt(value)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		value, err := strconv.ParseUint(text, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetUint(value)
+	case reflect.Float32, reflect.Float64:
+		value, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return err
+		}
+		field.SetFloat(value)
+	default:
+		return errors.New("unsupported field type " + field.Type().String())
+	}
+	return nil
+}
+
+//ItemMap returns the values of the SimpleItems of <items> by name
+func ItemMap(items onvif.ItemList) map[string]string {
+	values := make(map[string]string, len(items.SimpleItem))
+	for _, item := range items.SimpleItem {
+		values[item.Name] = string(item.Value)
+	}
+	return values
+}
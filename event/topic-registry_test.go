@@ -0,0 +1,42 @@
+package event
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+)
+
+func TestDecodeNotificationMessage(t *testing.T) {
+
+	data := `<wsnt:NotificationMessage xmlns:wsnt="http://docs.oasis-open.org/wsn/b-2" xmlns:tt="http://www.onvif.org/ver10/schema">
+		<wsnt:Topic Dialect="http://www.onvif.org/ver10/tev/topicExpression/ConcreteSet">ns2:VideoSource/MotionAlarm</wsnt:Topic>
+		<wsnt:Message><tt:Message UtcTime="2018-04-10T15:52:16Z" PropertyOperation="Changed">
+		<tt:Source><tt:SimpleItem Name="Source" Value="VideoSourceToken"/></tt:Source>
+		<tt:Data><tt:SimpleItem Name="State" Value="true"/></tt:Data>
+		</tt:Message></wsnt:Message></wsnt:NotificationMessage>`
+
+	var message NotificationMessage
+	if err := xml.Unmarshal([]byte(data), &message); err != nil {
+		t.Fatal(err)
+	}
+	payload, err := message.Decode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	alarm, ok := payload.(*MotionAlarm)
+	if !ok {
+		t.Fatalf("unexpected payload %#v", payload)
+	}
+	utcTime := time.Date(2018, 4, 10, 15, 52, 16, 0, time.UTC)
+	if alarm.Source != "VideoSourceToken" || !alarm.State || !alarm.UtcTime.Equal(utcTime) || alarm.PropertyOperation != "Changed" {
+		t.Errorf("unexpected alarm %+v", alarm)
+	}
+
+	message.Topic.Value = "tnsvendor:Storage/Alert"
+	if payload, err = message.Decode(); err != nil {
+		t.Fatal(err)
+	}
+	if notification, ok := payload.(*Notification); !ok || notification.Data["State"] != "true" {
+		t.Errorf("unexpected payload %#v", payload)
+	}
+}
@@ -0,0 +1,180 @@
+package event
+
+import (
+	"context"
+	"encoding/xml"
+	"strings"
+
+	"github.com/beevik/etree"
+	"github.com/use-go/goonvif"
+)
+
+//TopicSet topic tree of the events a device supports, returned by GetEventProperties
+type TopicSet struct {
+	Topics []*TopicNode
+}
+
+//TopicNode one element of a topic tree
+type TopicNode struct {
+	//Name qualified by the prefix the device uses, e.g. tns1:VideoSource
+	Name string
+	//Path topic expression of the node, e.g. tns1:VideoSource/MotionAlarm
+	Path string
+	//IsTopic the device notifies this topic (wstop:topic="true"), otherwise it only groups its children
+	IsTopic bool
+	//MessageDescription of the notifications of the topic, nil when the device did not describe them
+	MessageDescription *TopicMessageDescription
+	Children           []*TopicNode
+}
+
+//TopicMessageDescription items of the messages of a topic
+type TopicMessageDescription struct {
+	//IsProperty the topic is a property whose state is notified as Initialized, Changed and Deleted
+	IsProperty bool
+	Source     []TopicItemDescription
+	Key        []TopicItemDescription
+	Data       []TopicItemDescription
+}
+
+//TopicItemDescription name and type of a SimpleItem, e.g. State of type xs:boolean
+type TopicItemDescription struct {
+	Name string
+	Type string
+}
+
+//GetTopicSet returns the topic tree of <dev>
+func GetTopicSet(ctx context.Context, dev *goonvif.Device) (*TopicSet, error) {
+	var response GetEventPropertiesResponse
+	if err := dev.CallMethodUnmarshal(ctx, GetEventProperties{}, &response); err != nil {
+		return nil, err
+	}
+	return &response.TopicSet, nil
+}
+
+//UnmarshalXML decodes the arbitrary topic elements of a wstop:TopicSet.
+//The elements are read by etree, as the prefixes are needed for the topic expressions
+func (set *TopicSet) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var raw struct {
+		InnerXML string `xml:",innerxml"`
+	}
+	if err := d.DecodeElement(&raw, &start); err != nil {
+		return err
+	}
+
+	doc := etree.NewDocument()
+	if err := doc.ReadFromString("<TopicSet>" + raw.InnerXML + "</TopicSet>"); err != nil {
+		return err
+	}
+	set.Topics = topicNodes(doc.Root(), "", "")
+	return nil
+}
+
+func topicNodes(parent *etree.Element, parentPath, parentSpace string) []*TopicNode {
+	var nodes []*TopicNode
+	for _, element := range parent.ChildElements() {
+		if element.Tag == "MessageDescription" || element.Tag == "Documentation" {
+			continue
+		}
+
+		//children are written unqualified unless their namespace differs from the parent one,
+		//e.g. tns1:Device/tnshoneywell:Heartbeat
+		space := element.Space
+		name := element.Tag
+		if space != "" && space != parentSpace {
+			name = space + ":" + name
+		} else {
+			space = parentSpace
+		}
+		path := name
+		if parentPath != "" {
+			path = parentPath + "/" + name
+		}
+
+		node := &TopicNode{
+			Name:    element.FullTag(),
+			Path:    path,
+			IsTopic: strings.TrimSpace(attrValue(element, "topic")) == "true",
+		}
+		if description := element.SelectElement("MessageDescription"); description != nil {
+			node.MessageDescription = &TopicMessageDescription{
+				IsProperty: strings.TrimSpace(attrValue(description, "IsProperty")) == "true",
+				Source:     itemDescriptions(description.SelectElement("Source")),
+				Key:        itemDescriptions(description.SelectElement("Key")),
+				Data:       itemDescriptions(description.SelectElement("Data")),
+			}
+		}
+		node.Children = topicNodes(element, path, space)
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+func itemDescriptions(items *etree.Element) []TopicItemDescription {
+	if items == nil {
+		return nil
+	}
+	var descriptions []TopicItemDescription
+	for _, item := range items.SelectElements("SimpleItemDescription") {
+		descriptions = append(descriptions, TopicItemDescription{
+			Name: attrValue(item, "Name"),
+			Type: attrValue(item, "Type"),
+		})
+	}
+	return descriptions
+}
+
+//attrValue returns the attribute of <key> whatever its prefix
+func attrValue(element *etree.Element, key string) string {
+	for _, attr := range element.Attr {
+		if attr.Key == key {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+//Flatten returns the nodes of the tree that are topics, depth first
+func (set *TopicSet) Flatten() []*TopicNode {
+	var topics []*TopicNode
+	var walk func(nodes []*TopicNode)
+	walk = func(nodes []*TopicNode) {
+		for _, node := range nodes {
+			if node.IsTopic {
+				topics = append(topics, node)
+			}
+			walk(node.Children)
+		}
+	}
+	walk(set.Topics)
+	return topics
+}
+
+//Expressions returns the paths of the topics accepted by <match>, all topics when it is nil.
+//They are usable by NewTopicFilter, e.g. NewTopicFilter(set.Expressions(nil)...)
+func (set *TopicSet) Expressions(match func(*TopicNode) bool) []string {
+	var expressions []string
+	for _, node := range set.Flatten() {
+		if match == nil || match(node) {
+			expressions = append(expressions, node.Path)
+		}
+	}
+	return expressions
+}
+
+//Find returns the node of <path> ignoring namespace prefixes, nil when there is none
+func (set *TopicSet) Find(path string) *TopicNode {
+	path = TopicPath(path)
+	var find func(nodes []*TopicNode) *TopicNode
+	find = func(nodes []*TopicNode) *TopicNode {
+		for _, node := range nodes {
+			if TopicPath(node.Path) == path {
+				return node
+			}
+			if strings.HasPrefix(path, TopicPath(node.Path)+"/") {
+				return find(node.Children)
+			}
+		}
+		return nil
+	}
+	return find(set.Topics)
+}
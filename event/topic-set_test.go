@@ -0,0 +1,43 @@
+package event
+
+import (
+	"encoding/xml"
+	"reflect"
+	"testing"
+)
+
+func TestTopicSetUnmarshal(t *testing.T) {
+
+	data := `<tev:GetEventPropertiesResponse xmlns:tev="http://www.onvif.org/ver10/events/wsdl" xmlns:wstop="http://docs.oasis-open.org/wsn/t-1"
+		xmlns:tt="http://www.onvif.org/ver10/schema" xmlns:tns1="http://www.onvif.org/ver10/topics" xmlns:tnshoneywell="http://www.honeywell.com/topics">
+		<wstop:TopicSet>
+			<tns1:VideoSource>
+				<MotionAlarm wstop:topic="true">
+					<tt:MessageDescription IsProperty="true">
+						<tt:Source><tt:SimpleItemDescription Name="Source" Type="tt:ReferenceToken"/></tt:Source>
+						<tt:Data><tt:SimpleItemDescription Name="State" Type="xs:boolean"/></tt:Data>
+					</tt:MessageDescription>
+				</MotionAlarm>
+			</tns1:VideoSource>
+			<tns1:Device><tnshoneywell:Heartbeat wstop:topic="true"/></tns1:Device>
+		</wstop:TopicSet>
+	</tev:GetEventPropertiesResponse>`
+
+	var response GetEventPropertiesResponse
+	if err := xml.Unmarshal([]byte(data), &response); err != nil {
+		t.Fatal(err)
+	}
+
+	expressions := response.TopicSet.Expressions(nil)
+	if !reflect.DeepEqual(expressions, []string{"tns1:VideoSource/MotionAlarm", "tns1:Device/tnshoneywell:Heartbeat"}) {
+		t.Errorf("unexpected expressions %v", expressions)
+	}
+
+	motion := response.TopicSet.Find("ns2:VideoSource/MotionAlarm")
+	if motion == nil || motion.MessageDescription == nil || !motion.MessageDescription.IsProperty {
+		t.Fatalf("unexpected motion alarm %+v", motion)
+	}
+	if !reflect.DeepEqual(motion.MessageDescription.Data, []TopicItemDescription{{Name: "State", Type: "xs:boolean"}}) {
+		t.Errorf("unexpected data %+v", motion.MessageDescription.Data)
+	}
+}
@@ -3,6 +3,7 @@ package gosoap
 import (
 	"encoding/xml"
 	"log"
+	"time"
 
 	"github.com/beevik/etree"
 )
@@ -245,6 +246,12 @@ func (msg *SoapMessage) AddWSSecurity(username, password string) {
 	msg.addHeadSection(security)
 }
 
+//AddWSSecurityAt Header for soapMessage, the token is created at <created>
+func (msg *SoapMessage) AddWSSecurityAt(username, password string, created time.Time) {
+	security := NewSecurityAt(username, password, created)
+	msg.addHeadSection(security)
+}
+
 // //AddAction Header handling for soapMessage
 // func (msg *SoapMessage) AddAction() {
 
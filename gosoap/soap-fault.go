@@ -0,0 +1,178 @@
+package gosoap
+
+import (
+	"strings"
+
+	"github.com/beevik/etree"
+)
+
+/*************************
+	SOAP Fault
+*************************/
+
+//FaultCode a fault code or subcode QName, e.g. env:Sender or ter:NotAuthorized.
+//It is used as errors.Is target for Fault, prefixes are not compared
+//because every device picks its own ones
+type FaultCode string
+
+func (code FaultCode) Error() string {
+	return "soap fault " + string(code)
+}
+
+//Common SOAP 1.2 fault codes
+var (
+	ErrVersionMismatch     = FaultCode("env:VersionMismatch")
+	ErrMustUnderstand      = FaultCode("env:MustUnderstand")
+	ErrDataEncodingUnknown = FaultCode("env:DataEncodingUnknown")
+	ErrSender              = FaultCode("env:Sender")
+	ErrReceiver            = FaultCode("env:Receiver")
+)
+
+//Common ONVIF fault subcodes (ter namespace http://www.onvif.org/ver10/error)
+var (
+	ErrNotAuthorized       = FaultCode("ter:NotAuthorized")
+	ErrActionNotSupported  = FaultCode("ter:ActionNotSupported")
+	ErrInvalidArgs         = FaultCode("ter:InvalidArgs")
+	ErrInvalidArgVal       = FaultCode("ter:InvalidArgVal")
+	ErrInvalidArg          = FaultCode("ter:InvalidArg")
+	ErrOperationProhibited = FaultCode("ter:OperationProhibited")
+	ErrNoProfile           = FaultCode("ter:NoProfile")
+	ErrNoConfig            = FaultCode("ter:NoConfig")
+	ErrNoSource            = FaultCode("ter:NoSource")
+	ErrNoEntity            = FaultCode("ter:NoEntity")
+	ErrConfigModify        = FaultCode("ter:ConfigModify")
+	ErrMaxNVTProfiles      = FaultCode("ter:MaxNVTProfiles")
+	ErrInvalidStreamSetup  = FaultCode("ter:InvalidStreamSetup")
+	ErrStreamConflict      = FaultCode("ter:StreamConflict")
+	ErrNoSuchService       = FaultCode("ter:NoSuchService")
+	ErrResourceUnknown     = FaultCode("ter:ResourceUnknown")
+)
+
+//Fault SOAP fault returned by a device
+/*
+   <env:Fault>
+       <env:Code>
+           <env:Value>env:Sender</env:Value>
+           <env:Subcode>
+               <env:Value>ter:NotAuthorized</env:Value>
+           </env:Subcode>
+       </env:Code>
+       <env:Reason>
+           <env:Text xml:lang="en">Sender not Authorized</env:Text>
+       </env:Reason>
+   </env:Fault>
+*/
+type Fault struct {
+	//Code top level fault code, e.g. env:Sender
+	Code string
+	//Subcode chain from outermost to innermost, e.g. [ter:InvalidArgVal ter:NoProfile]
+	Subcode []string
+	//Reason human readable explanation
+	Reason string
+	//Detail raw xml content of the Detail element
+	Detail string
+}
+
+func (fault *Fault) Error() string {
+	codes := append([]string{fault.Code}, fault.Subcode...)
+	msg := "soap fault " + strings.Join(codes, "/")
+	if fault.Reason != "" {
+		msg += ": " + fault.Reason
+	}
+	return msg
+}
+
+//Is reports whether target is a FaultCode matching the code or one of the subcodes
+func (fault *Fault) Is(target error) bool {
+	code, ok := target.(FaultCode)
+	if !ok {
+		return false
+	}
+	name := localName(string(code))
+	if localName(fault.Code) == name {
+		return true
+	}
+	for _, subcode := range fault.Subcode {
+		if localName(subcode) == name {
+			return true
+		}
+	}
+	return false
+}
+
+//HasSubcode reports whether the fault carries <code> in its subcode chain
+func (fault *Fault) HasSubcode(code string) bool {
+	name := localName(code)
+	for _, subcode := range fault.Subcode {
+		if localName(subcode) == name {
+			return true
+		}
+	}
+	return false
+}
+
+//GetFault returns the Fault held by a soap Body element, or nil if there is none.
+//Element prefixes are ignored, SOAP 1.1 faults (faultcode/faultstring) are handled too
+func GetFault(body *etree.Element) *Fault {
+	if body == nil {
+		return nil
+	}
+	element := body.SelectElement("Fault")
+	if element == nil {
+		return nil
+	}
+	return ParseFault(element)
+}
+
+//ParseFault converts a Fault element into Fault
+func ParseFault(element *etree.Element) *Fault {
+	fault := new(Fault)
+
+	if code := element.SelectElement("Code"); code != nil {
+		fault.Code = childText(code, "Value")
+		for subcode := code.SelectElement("Subcode"); subcode != nil; subcode = subcode.SelectElement("Subcode") {
+			fault.Subcode = append(fault.Subcode, childText(subcode, "Value"))
+		}
+	} else {
+		//SOAP 1.1
+		fault.Code = childText(element, "faultcode")
+	}
+
+	if reason := element.SelectElement("Reason"); reason != nil {
+		fault.Reason = childText(reason, "Text")
+	} else {
+		fault.Reason = childText(element, "faultstring")
+	}
+
+	detail := element.SelectElement("Detail")
+	if detail == nil {
+		detail = element.SelectElement("detail")
+	}
+	if detail != nil {
+		doc := etree.NewDocument()
+		for _, child := range detail.ChildElements() {
+			doc.AddChild(child.Copy())
+		}
+		fault.Detail, _ = doc.WriteToString()
+		if fault.Detail == "" {
+			fault.Detail = strings.TrimSpace(detail.Text())
+		}
+	}
+
+	return fault
+}
+
+func childText(element *etree.Element, tag string) string {
+	child := element.SelectElement(tag)
+	if child == nil {
+		return ""
+	}
+	return strings.TrimSpace(child.Text())
+}
+
+func localName(qname string) string {
+	if i := strings.LastIndex(qname, ":"); i >= 0 {
+		return qname[i+1:]
+	}
+	return qname
+}
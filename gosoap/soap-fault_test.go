@@ -0,0 +1,83 @@
+package gosoap
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/beevik/etree"
+)
+
+func TestGetFault(t *testing.T) {
+
+	mockedFaults := []string{
+		`<SOAP-ENV:Envelope xmlns:SOAP-ENV="http://www.w3.org/2003/05/soap-envelope" xmlns:ter="http://www.onvif.org/ver10/error">
+			<SOAP-ENV:Body><SOAP-ENV:Fault>
+				<SOAP-ENV:Code><SOAP-ENV:Value>SOAP-ENV:Sender</SOAP-ENV:Value>
+					<SOAP-ENV:Subcode><SOAP-ENV:Value>ter:NotAuthorized</SOAP-ENV:Value></SOAP-ENV:Subcode>
+				</SOAP-ENV:Code>
+				<SOAP-ENV:Reason><SOAP-ENV:Text xml:lang="en">Sender not Authorized</SOAP-ENV:Text></SOAP-ENV:Reason>
+			</SOAP-ENV:Fault></SOAP-ENV:Body>
+		</SOAP-ENV:Envelope>`,
+		`<s:Envelope xmlns:s="http://www.w3.org/2003/05/soap-envelope" xmlns:e="http://www.onvif.org/ver10/error">
+			<s:Body><s:Fault>
+				<s:Code><s:Value>s:Sender</s:Value>
+					<s:Subcode><s:Value>e:NotAuthorized</s:Value></s:Subcode>
+				</s:Code>
+				<s:Reason><s:Text xml:lang="en">Sender not Authorized</s:Text></s:Reason>
+			</s:Fault></s:Body>
+		</s:Envelope>`,
+	}
+
+	for _, mockedFault := range mockedFaults {
+		doc := etree.NewDocument()
+		if err := doc.ReadFromString(mockedFault); err != nil {
+			t.Fatal(err)
+		}
+
+		fault := GetFault(doc.FindElement("./Envelope/Body"))
+		if fault == nil {
+			t.Fatal("fault not found")
+		}
+		if fault.Reason != "Sender not Authorized" || len(fault.Subcode) != 1 {
+			t.Errorf("unexpected fault %#v", fault)
+		}
+
+		var err error = fault
+		if !errors.Is(err, ErrNotAuthorized) || !errors.Is(err, ErrSender) {
+			t.Errorf("%v should match ErrNotAuthorized and ErrSender", err)
+		}
+		if errors.Is(err, ErrInvalidArgVal) {
+			t.Errorf("%v should not match ErrInvalidArgVal", err)
+		}
+	}
+}
+
+func TestParseFaultSubcodeChain(t *testing.T) {
+
+	mockedFault := `<env:Fault xmlns:env="http://www.w3.org/2003/05/soap-envelope">
+		<env:Code><env:Value>env:Sender</env:Value>
+			<env:Subcode><env:Value>ter:InvalidArgVal</env:Value>
+				<env:Subcode><env:Value>ter:NoProfile</env:Value></env:Subcode>
+			</env:Subcode>
+		</env:Code>
+		<env:Reason><env:Text>No such profile</env:Text></env:Reason>
+		<env:Detail><env:Text>profile_3</env:Text></env:Detail>
+	</env:Fault>`
+
+	doc := etree.NewDocument()
+	if err := doc.ReadFromString(mockedFault); err != nil {
+		t.Fatal(err)
+	}
+	fault := ParseFault(doc.Root())
+
+	if len(fault.Subcode) != 2 || fault.Subcode[1] != "ter:NoProfile" {
+		t.Errorf("unexpected subcodes %v", fault.Subcode)
+	}
+	if !fault.HasSubcode("ter:InvalidArgVal") || !errors.Is(fault, ErrNoProfile) {
+		t.Errorf("%v should match InvalidArgVal and NoProfile", fault)
+	}
+	if fault.Detail == "" {
+		t.Error("detail is empty")
+	}
+	t.Log(fault)
+}
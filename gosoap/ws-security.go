@@ -70,6 +70,12 @@ type usernameToken struct {
 
 //NewSecurity get a new security
 func NewSecurity(username, passwd string) Security {
+	return NewSecurityAt(username, passwd, time.Now())
+}
+
+//NewSecurityAt get a new security created at <created>,
+//pass the device clock here when it drifts from the local one
+func NewSecurityAt(username, passwd string, created time.Time) Security {
 	/** Generating Nonce sequence **/
 	charsToGenerate := 32
 	charSet := gostrgen.Lower | gostrgen.Digit
@@ -77,7 +83,7 @@ func NewSecurity(username, passwd string) Security {
 	nonceSeq, _ := gostrgen.RandGen(charsToGenerate, charSet, "", "")
 
 	//use the same time for password and Created fileld !
-	nowTimeUTC := time.Now().UTC()
+	nowTimeUTC := created.UTC()
 
 	return Security{
 		UsernameToken: usernameToken{
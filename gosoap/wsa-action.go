@@ -17,6 +17,8 @@ const (
 	PauseSubscriptionActionValue  = "http://docs.oasis-open.org/wsn/bw-2/PausableSubscriptionManager/PauseSubscriptionRequest"
 	UnsubscribeActionValue        = "http://docs.oasis-open.org/wsn/bw-2/SubscriptionManager/UnsubscribeRequest"
 	RenewRequestActionValue       = "http://docs.oasis-open.org/wsn/bw-2/SubscriptionManager/RenewRequest"
+
+	PullMessagesActionValue = "http://www.onvif.org/ver10/events/wsdl/PullPointSubscription/PullMessagesRequest"
 )
 
 //Xlmns XML Scheam
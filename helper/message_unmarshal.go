@@ -8,6 +8,7 @@ import (
 	"io/ioutil"
 
 	"github.com/beevik/etree"
+	"github.com/use-go/goonvif/gosoap"
 )
 
 //Key Field
@@ -17,7 +18,7 @@ const (
 	SOAPENVFault    = "SOAP-ENV:Fault"
 )
 
-//GetBody from http resp
+//GetBody from http resp, a soap fault in body is returned as *gosoap.Fault
 func GetBody(messageBody []byte, tdsName string) ([]byte, error) {
 
 	doc := etree.NewDocument()
@@ -32,15 +33,9 @@ func GetBody(messageBody []byte, tdsName string) ([]byte, error) {
 		return messageBody, errors.New("bay response body")
 	}
 
-	fault := body.FindElement(SOAPENVFault)
-	if fault != nil {
-		doc.SetRoot(fault)
-		buf, err := doc.WriteToBytes()
-		if err != nil {
-			return nil, err
-		}
-		//	log.Printf("%s\n", buf)
-		return nil, errors.New(string(buf))
+	//fault may use any envelope prefix (SOAP-ENV:, s:, env: ...)
+	if fault := gosoap.GetFault(body); fault != nil {
+		return nil, fault
 	}
 
 	content := body.SelectElement(tdsName)
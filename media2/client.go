@@ -0,0 +1,117 @@
+package media2
+
+//go:generate go run ../cmd/clientgen -types types.go -package media2 -client Client -out media2-client.go
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/use-go/goonvif"
+	"github.com/use-go/goonvif/xsd"
+	"github.com/use-go/goonvif/xsd/onvif"
+)
+
+//Transport protocols of GetStreamUri
+const (
+	TransportProtocolRtspUnicast   = TransportProtocol("RtspUnicast")
+	TransportProtocolRtspMulticast = TransportProtocol("RtspMulticast")
+	TransportProtocolRTSP          = TransportProtocol("RTSP")
+	TransportProtocolRtspOverHttp  = TransportProtocol("RtspOverHttp")
+)
+
+//Configuration types of a media profile
+const (
+	ConfigurationAll          = ConfigurationEnumeration("All")
+	ConfigurationVideoSource  = ConfigurationEnumeration("VideoSource")
+	ConfigurationVideoEncoder = ConfigurationEnumeration("VideoEncoder")
+	ConfigurationAudioSource  = ConfigurationEnumeration("AudioSource")
+	ConfigurationAudioEncoder = ConfigurationEnumeration("AudioEncoder")
+	ConfigurationAudioOutput  = ConfigurationEnumeration("AudioOutput")
+	ConfigurationAudioDecoder = ConfigurationEnumeration("AudioDecoder")
+	ConfigurationMetadata     = ConfigurationEnumeration("Metadata")
+	ConfigurationAnalytics    = ConfigurationEnumeration("Analytics")
+	ConfigurationPTZ          = ConfigurationEnumeration("PTZ")
+)
+
+//Mask types of a privacy mask
+const (
+	MaskTypeColor     = MaskType("Color")
+	MaskTypePixelated = MaskType("Pixelated")
+	MaskTypeBlurred   = MaskType("Blurred")
+)
+
+//Video encodings (mime names) of a VideoEncoder2Configuration
+const (
+	EncodingJPEG  = xsd.String("JPEG")
+	EncodingMPEG4 = xsd.String("MPV4-ES")
+	EncodingH264  = xsd.String("H264")
+	EncodingH265  = xsd.String("H265")
+)
+
+//ErrNoMatchingProfile returned when no media profile fits the request
+var ErrNoMatchingProfile = errors.New("no matching media profile")
+
+//Client typed client of the ONVIF Media2 service,
+//its methods are generated from types.go by cmd/clientgen
+type Client struct {
+	dev *goonvif.Device
+}
+
+//NewClient returns a Media2 service client of dev
+func NewClient(dev *goonvif.Device) *Client {
+	return &Client{dev: dev}
+}
+
+//ProfileStreamUri media profile with its stream uri
+type ProfileStreamUri struct {
+	Profile MediaProfile
+	Uri     string
+}
+
+//GetStreamUris returns the stream uri of every media profile by <protocol>
+//(e.g. TransportProtocolRTSP)
+func (c *Client) GetStreamUris(ctx context.Context, protocol TransportProtocol) ([]ProfileStreamUri, error) {
+	profiles, err := c.GetProfiles(ctx, GetProfiles{Type: []ConfigurationEnumeration{ConfigurationAll}})
+	if err != nil {
+		return nil, err
+	}
+
+	uris := make([]ProfileStreamUri, 0, len(profiles.Profiles))
+	for _, profile := range profiles.Profiles {
+		uri, err := c.GetStreamUri(ctx, GetStreamUri{Protocol: protocol, ProfileToken: profile.Token})
+		if err != nil {
+			return nil, err
+		}
+		uris = append(uris, ProfileStreamUri{Profile: profile, Uri: strings.TrimSpace(string(uri.Uri))})
+	}
+	return uris, nil
+}
+
+//GetHighestResolutionProfile returns the media profile with the largest
+//video resolution among those encoding with <encoding> (e.g. EncodingH265)
+func (c *Client) GetHighestResolutionProfile(ctx context.Context, encoding xsd.String) (*MediaProfile, error) {
+	profiles, err := c.GetProfiles(ctx, GetProfiles{Type: []ConfigurationEnumeration{ConfigurationVideoEncoder}})
+	if err != nil {
+		return nil, err
+	}
+
+	profile, found := HighestResolutionProfile(profiles.Profiles, encoding)
+	if !found {
+		return nil, ErrNoMatchingProfile
+	}
+	return &profile, nil
+}
+
+//HighestResolutionProfile picks the profile with the most pixels whose video encoder uses <encoding>,
+//an empty encoding matches all profiles having a video encoder
+func HighestResolutionProfile(profiles []MediaProfile, encoding xsd.String) (MediaProfile, bool) {
+	best := onvif.HighestResolution(len(profiles), func(i int) (string, onvif.VideoResolution) {
+		encoder := profiles[i].Configurations.VideoEncoder
+		return string(encoder.Encoding), onvif.VideoResolution(encoder.Resolution)
+	}, string(encoding))
+	if best < 0 {
+		return MediaProfile{}, false
+	}
+	return profiles[best], true
+}
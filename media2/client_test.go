@@ -0,0 +1,42 @@
+package media2
+
+import (
+	"testing"
+
+	"github.com/use-go/goonvif/xsd"
+	"github.com/use-go/goonvif/xsd/onvif"
+)
+
+//profile returns a media profile of <token> with a video encoder of <encoding> at <width>x<height>,
+//none when the encoding is empty
+func profile(token string, encoding xsd.String, width, height int) MediaProfile {
+	profile := MediaProfile{Token: onvif.ReferenceToken(token)}
+	profile.Configurations.VideoEncoder.Encoding = encoding
+	profile.Configurations.VideoEncoder.Resolution = onvif.VideoResolution2{Width: xsd.Int(width), Height: xsd.Int(height)}
+	return profile
+}
+
+func TestHighestResolutionProfile(t *testing.T) {
+
+	profiles := []MediaProfile{
+		profile("h264", EncodingH264, 1920, 1080),
+		profile("h265", EncodingH265, 2560, 1440),
+		profile("h265-portrait", "h265", 1440, 2560),
+		profile("metadata", "", 0, 0),
+	}
+	for _, test := range []struct {
+		encoding xsd.String
+		expected onvif.ReferenceToken
+	}{
+		//the encoding is compared case insensitive and the first profile wins a tie
+		{EncodingH265, "h265"},
+		{EncodingH264, "h264"},
+		{"", "h265"},
+		{EncodingJPEG, ""},
+	} {
+		best, found := HighestResolutionProfile(profiles, test.encoding)
+		if found != (test.expected != "") || best.Token != test.expected {
+			t.Errorf("expected %q for %q, got %q %v", test.expected, test.encoding, best.Token, found)
+		}
+	}
+}
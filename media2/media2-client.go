@@ -0,0 +1,421 @@
+// Code generated by clientgen from media2/types.go; DO NOT EDIT.
+
+package media2
+
+import (
+	"context"
+)
+
+//AddConfiguration calls tr2:AddConfiguration and returns its response
+func (c *Client) AddConfiguration(ctx context.Context, request AddConfiguration) (*AddConfigurationResponse, error) {
+	var response AddConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//CreateMask calls tr2:CreateMask and returns its response
+func (c *Client) CreateMask(ctx context.Context, request CreateMask) (*CreateMaskResponse, error) {
+	var response CreateMaskResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//CreateOSD calls tr2:CreateOSD and returns its response
+func (c *Client) CreateOSD(ctx context.Context, request CreateOSD) (*CreateOSDResponse, error) {
+	var response CreateOSDResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//CreateProfile calls tr2:CreateProfile and returns its response
+func (c *Client) CreateProfile(ctx context.Context, request CreateProfile) (*CreateProfileResponse, error) {
+	var response CreateProfileResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//DeleteMask calls tr2:DeleteMask and returns its response
+func (c *Client) DeleteMask(ctx context.Context, request DeleteMask) (*DeleteMaskResponse, error) {
+	var response DeleteMaskResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//DeleteOSD calls tr2:DeleteOSD and returns its response
+func (c *Client) DeleteOSD(ctx context.Context, request DeleteOSD) (*DeleteOSDResponse, error) {
+	var response DeleteOSDResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//DeleteProfile calls tr2:DeleteProfile and returns its response
+func (c *Client) DeleteProfile(ctx context.Context, request DeleteProfile) (*DeleteProfileResponse, error) {
+	var response DeleteProfileResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetAnalyticsConfigurations calls tr2:GetAnalyticsConfigurations and returns its response
+func (c *Client) GetAnalyticsConfigurations(ctx context.Context, request GetAnalyticsConfigurations) (*GetAnalyticsConfigurationsResponse, error) {
+	var response GetAnalyticsConfigurationsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetAudioDecoderConfigurationOptions calls tr2:GetAudioDecoderConfigurationOptions and returns its response
+func (c *Client) GetAudioDecoderConfigurationOptions(ctx context.Context, request GetAudioDecoderConfigurationOptions) (*GetAudioDecoderConfigurationOptionsResponse, error) {
+	var response GetAudioDecoderConfigurationOptionsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetAudioDecoderConfigurations calls tr2:GetAudioDecoderConfigurations and returns its response
+func (c *Client) GetAudioDecoderConfigurations(ctx context.Context, request GetAudioDecoderConfigurations) (*GetAudioDecoderConfigurationsResponse, error) {
+	var response GetAudioDecoderConfigurationsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetAudioEncoderConfigurationOptions calls tr2:GetAudioEncoderConfigurationOptions and returns its response
+func (c *Client) GetAudioEncoderConfigurationOptions(ctx context.Context, request GetAudioEncoderConfigurationOptions) (*GetAudioEncoderConfigurationOptionsResponse, error) {
+	var response GetAudioEncoderConfigurationOptionsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetAudioEncoderConfigurations calls tr2:GetAudioEncoderConfigurations and returns its response
+func (c *Client) GetAudioEncoderConfigurations(ctx context.Context, request GetAudioEncoderConfigurations) (*GetAudioEncoderConfigurationsResponse, error) {
+	var response GetAudioEncoderConfigurationsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetAudioOutputConfigurationOptions calls tr2:GetAudioOutputConfigurationOptions and returns its response
+func (c *Client) GetAudioOutputConfigurationOptions(ctx context.Context, request GetAudioOutputConfigurationOptions) (*GetAudioOutputConfigurationOptionsResponse, error) {
+	var response GetAudioOutputConfigurationOptionsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetAudioOutputConfigurations calls tr2:GetAudioOutputConfigurations and returns its response
+func (c *Client) GetAudioOutputConfigurations(ctx context.Context, request GetAudioOutputConfigurations) (*GetAudioOutputConfigurationsResponse, error) {
+	var response GetAudioOutputConfigurationsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetAudioSourceConfigurationOptions calls tr2:GetAudioSourceConfigurationOptions and returns its response
+func (c *Client) GetAudioSourceConfigurationOptions(ctx context.Context, request GetAudioSourceConfigurationOptions) (*GetAudioSourceConfigurationOptionsResponse, error) {
+	var response GetAudioSourceConfigurationOptionsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetAudioSourceConfigurations calls tr2:GetAudioSourceConfigurations and returns its response
+func (c *Client) GetAudioSourceConfigurations(ctx context.Context, request GetAudioSourceConfigurations) (*GetAudioSourceConfigurationsResponse, error) {
+	var response GetAudioSourceConfigurationsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetMaskOptions calls tr2:GetMaskOptions and returns its response
+func (c *Client) GetMaskOptions(ctx context.Context, request GetMaskOptions) (*GetMaskOptionsResponse, error) {
+	var response GetMaskOptionsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetMasks calls tr2:GetMasks and returns its response
+func (c *Client) GetMasks(ctx context.Context, request GetMasks) (*GetMasksResponse, error) {
+	var response GetMasksResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetMetadataConfigurationOptions calls tr2:GetMetadataConfigurationOptions and returns its response
+func (c *Client) GetMetadataConfigurationOptions(ctx context.Context, request GetMetadataConfigurationOptions) (*GetMetadataConfigurationOptionsResponse, error) {
+	var response GetMetadataConfigurationOptionsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetMetadataConfigurations calls tr2:GetMetadataConfigurations and returns its response
+func (c *Client) GetMetadataConfigurations(ctx context.Context, request GetMetadataConfigurations) (*GetMetadataConfigurationsResponse, error) {
+	var response GetMetadataConfigurationsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetOSDOptions calls tr2:GetOSDOptions and returns its response
+func (c *Client) GetOSDOptions(ctx context.Context, request GetOSDOptions) (*GetOSDOptionsResponse, error) {
+	var response GetOSDOptionsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetOSDs calls tr2:GetOSDs and returns its response
+func (c *Client) GetOSDs(ctx context.Context, request GetOSDs) (*GetOSDsResponse, error) {
+	var response GetOSDsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetProfiles calls tr2:GetProfiles and returns its response
+func (c *Client) GetProfiles(ctx context.Context, request GetProfiles) (*GetProfilesResponse, error) {
+	var response GetProfilesResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetServiceCapabilities calls tr2:GetServiceCapabilities and returns its response
+func (c *Client) GetServiceCapabilities(ctx context.Context, request GetServiceCapabilities) (*GetServiceCapabilitiesResponse, error) {
+	var response GetServiceCapabilitiesResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetSnapshotUri calls tr2:GetSnapshotUri and returns its response
+func (c *Client) GetSnapshotUri(ctx context.Context, request GetSnapshotUri) (*GetSnapshotUriResponse, error) {
+	var response GetSnapshotUriResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetStreamUri calls tr2:GetStreamUri and returns its response
+func (c *Client) GetStreamUri(ctx context.Context, request GetStreamUri) (*GetStreamUriResponse, error) {
+	var response GetStreamUriResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetVideoEncoderConfigurationOptions calls tr2:GetVideoEncoderConfigurationOptions and returns its response
+func (c *Client) GetVideoEncoderConfigurationOptions(ctx context.Context, request GetVideoEncoderConfigurationOptions) (*GetVideoEncoderConfigurationOptionsResponse, error) {
+	var response GetVideoEncoderConfigurationOptionsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetVideoEncoderConfigurations calls tr2:GetVideoEncoderConfigurations and returns its response
+func (c *Client) GetVideoEncoderConfigurations(ctx context.Context, request GetVideoEncoderConfigurations) (*GetVideoEncoderConfigurationsResponse, error) {
+	var response GetVideoEncoderConfigurationsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetVideoEncoderInstances calls tr2:GetVideoEncoderInstances and returns its response
+func (c *Client) GetVideoEncoderInstances(ctx context.Context, request GetVideoEncoderInstances) (*GetVideoEncoderInstancesResponse, error) {
+	var response GetVideoEncoderInstancesResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetVideoSourceConfigurationOptions calls tr2:GetVideoSourceConfigurationOptions and returns its response
+func (c *Client) GetVideoSourceConfigurationOptions(ctx context.Context, request GetVideoSourceConfigurationOptions) (*GetVideoSourceConfigurationOptionsResponse, error) {
+	var response GetVideoSourceConfigurationOptionsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetVideoSourceConfigurations calls tr2:GetVideoSourceConfigurations and returns its response
+func (c *Client) GetVideoSourceConfigurations(ctx context.Context, request GetVideoSourceConfigurations) (*GetVideoSourceConfigurationsResponse, error) {
+	var response GetVideoSourceConfigurationsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetVideoSourceModes calls tr2:GetVideoSourceModes and returns its response
+func (c *Client) GetVideoSourceModes(ctx context.Context, request GetVideoSourceModes) (*GetVideoSourceModesResponse, error) {
+	var response GetVideoSourceModesResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//RemoveConfiguration calls tr2:RemoveConfiguration and returns its response
+func (c *Client) RemoveConfiguration(ctx context.Context, request RemoveConfiguration) (*RemoveConfigurationResponse, error) {
+	var response RemoveConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetAudioDecoderConfiguration calls tr2:SetAudioDecoderConfiguration and returns its response
+func (c *Client) SetAudioDecoderConfiguration(ctx context.Context, request SetAudioDecoderConfiguration) (*SetAudioDecoderConfigurationResponse, error) {
+	var response SetAudioDecoderConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetAudioEncoderConfiguration calls tr2:SetAudioEncoderConfiguration and returns its response
+func (c *Client) SetAudioEncoderConfiguration(ctx context.Context, request SetAudioEncoderConfiguration) (*SetAudioEncoderConfigurationResponse, error) {
+	var response SetAudioEncoderConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetAudioOutputConfiguration calls tr2:SetAudioOutputConfiguration and returns its response
+func (c *Client) SetAudioOutputConfiguration(ctx context.Context, request SetAudioOutputConfiguration) (*SetAudioOutputConfigurationResponse, error) {
+	var response SetAudioOutputConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetAudioSourceConfiguration calls tr2:SetAudioSourceConfiguration and returns its response
+func (c *Client) SetAudioSourceConfiguration(ctx context.Context, request SetAudioSourceConfiguration) (*SetAudioSourceConfigurationResponse, error) {
+	var response SetAudioSourceConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetMask calls tr2:SetMask and returns its response
+func (c *Client) SetMask(ctx context.Context, request SetMask) (*SetMaskResponse, error) {
+	var response SetMaskResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetMetadataConfiguration calls tr2:SetMetadataConfiguration and returns its response
+func (c *Client) SetMetadataConfiguration(ctx context.Context, request SetMetadataConfiguration) (*SetMetadataConfigurationResponse, error) {
+	var response SetMetadataConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetOSD calls tr2:SetOSD and returns its response
+func (c *Client) SetOSD(ctx context.Context, request SetOSD) (*SetOSDResponse, error) {
+	var response SetOSDResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetSynchronizationPoint calls tr2:SetSynchronizationPoint and returns its response
+func (c *Client) SetSynchronizationPoint(ctx context.Context, request SetSynchronizationPoint) (*SetSynchronizationPointResponse, error) {
+	var response SetSynchronizationPointResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetVideoEncoderConfiguration calls tr2:SetVideoEncoderConfiguration and returns its response
+func (c *Client) SetVideoEncoderConfiguration(ctx context.Context, request SetVideoEncoderConfiguration) (*SetVideoEncoderConfigurationResponse, error) {
+	var response SetVideoEncoderConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetVideoSourceConfiguration calls tr2:SetVideoSourceConfiguration and returns its response
+func (c *Client) SetVideoSourceConfiguration(ctx context.Context, request SetVideoSourceConfiguration) (*SetVideoSourceConfigurationResponse, error) {
+	var response SetVideoSourceConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetVideoSourceMode calls tr2:SetVideoSourceMode and returns its response
+func (c *Client) SetVideoSourceMode(ctx context.Context, request SetVideoSourceMode) (*SetVideoSourceModeResponse, error) {
+	var response SetVideoSourceModeResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//StartMulticastStreaming calls tr2:StartMulticastStreaming and returns its response
+func (c *Client) StartMulticastStreaming(ctx context.Context, request StartMulticastStreaming) (*StartMulticastStreamingResponse, error) {
+	var response StartMulticastStreamingResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//StopMulticastStreaming calls tr2:StopMulticastStreaming and returns its response
+func (c *Client) StopMulticastStreaming(ctx context.Context, request StopMulticastStreaming) (*StopMulticastStreamingResponse, error) {
+	var response StopMulticastStreamingResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
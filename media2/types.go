@@ -0,0 +1,528 @@
+package media2
+
+import (
+	"github.com/use-go/goonvif/xsd"
+	"github.com/use-go/goonvif/xsd/onvif"
+)
+
+type Capabilities struct {
+	SnapshotUri           xsd.Boolean `xml:"SnapshotUri,attr"`
+	Rotation              xsd.Boolean `xml:"Rotation,attr"`
+	VideoSourceMode       xsd.Boolean `xml:"VideoSourceMode,attr"`
+	OSD                   xsd.Boolean `xml:"OSD,attr"`
+	TemporaryOSDText      xsd.Boolean `xml:"TemporaryOSDText,attr"`
+	Mask                  xsd.Boolean `xml:"Mask,attr"`
+	SourceMask            xsd.Boolean `xml:"SourceMask,attr"`
+	ProfileCapabilities   ProfileCapabilities
+	StreamingCapabilities StreamingCapabilities
+}
+
+type ProfileCapabilities struct {
+	MaximumNumberOfProfiles int                  `xml:"MaximumNumberOfProfiles,attr"`
+	ConfigurationsSupported onvif.StringAttrList `xml:"ConfigurationsSupported,attr"`
+}
+
+type StreamingCapabilities struct {
+	RTSPStreaming       xsd.Boolean `xml:"RTSPStreaming,attr"`
+	RTPMulticast        xsd.Boolean `xml:"RTPMulticast,attr"`
+	RTP_RTSP_TCP        xsd.Boolean `xml:"RTP_RTSP_TCP,attr"`
+	NonAggregateControl xsd.Boolean `xml:"NonAggregateControl,attr"`
+	RTSPWebSocketUri    xsd.AnyURI  `xml:"RTSPWebSocketUri,attr"`
+	AutoStartMulticast  xsd.Boolean `xml:"AutoStartMulticast,attr"`
+}
+
+//ConfigurationEnumeration type of a configuration in a profile, see the Configuration constants
+type ConfigurationEnumeration xsd.String
+
+type ConfigurationRef struct {
+	Type  ConfigurationEnumeration `xml:"tr2:Type"`
+	Token onvif.ReferenceToken     `xml:"tr2:Token,omitempty"`
+}
+
+type ConfigurationSet struct {
+	VideoSource  onvif.VideoSourceConfiguration    `xml:"VideoSource"`
+	AudioSource  onvif.AudioSourceConfiguration    `xml:"AudioSource"`
+	VideoEncoder onvif.VideoEncoder2Configuration  `xml:"VideoEncoder"`
+	AudioEncoder onvif.AudioEncoder2Configuration  `xml:"AudioEncoder"`
+	Analytics    onvif.VideoAnalyticsConfiguration `xml:"Analytics"`
+	PTZ          onvif.PTZConfiguration            `xml:"PTZ"`
+	Metadata     onvif.MetadataConfiguration       `xml:"Metadata"`
+	AudioOutput  onvif.AudioOutputConfiguration    `xml:"AudioOutput"`
+	AudioDecoder onvif.AudioDecoderConfiguration   `xml:"AudioDecoder"`
+}
+
+//MediaProfile a media profile of the Media2 service
+type MediaProfile struct {
+	Token          onvif.ReferenceToken `xml:"token,attr"`
+	Fixed          xsd.Boolean          `xml:"fixed,attr"`
+	Name           onvif.Name           `xml:"Name"`
+	Configurations ConfigurationSet     `xml:"Configurations"`
+}
+
+//TransportProtocol of GetStreamUri, see the TransportProtocol constants
+type TransportProtocol xsd.String
+
+type EncoderInstance struct {
+	Encoding xsd.String
+	Number   int
+}
+
+type EncoderInstanceInfo struct {
+	Codec []EncoderInstance
+	Total int
+}
+
+type VideoSourceMode struct {
+	Token         onvif.ReferenceToken  `xml:"token,attr"`
+	Enabled       xsd.Boolean           `xml:"Enabled,attr"`
+	MaxFramerate  xsd.Float             `xml:"MaxFramerate"`
+	MaxResolution onvif.VideoResolution `xml:"MaxResolution"`
+	Encodings     xsd.String            `xml:"Encodings"`
+	Reboot        xsd.Boolean           `xml:"Reboot"`
+	Description   onvif.Description     `xml:"Description"`
+}
+
+//MaskType of a privacy mask, see the MaskType constants
+type MaskType xsd.String
+
+type Mask struct {
+	Token                    onvif.ReferenceToken `xml:"token,attr,omitempty"`
+	VideoSourceConfiguration onvif.ReferenceToken `xml:"VideoSourceConfiguration,attr,omitempty"`
+	ConfigurationToken       onvif.ReferenceToken `xml:"ConfigurationToken"`
+	Polygon                  onvif.Polygon        `xml:"Polygon"`
+	Type                     MaskType             `xml:"Type"`
+	Color                    onvif.Color          `xml:"Color"`
+	Enabled                  xsd.Boolean          `xml:"Enabled"`
+}
+
+type MaskOptions struct {
+	RectangleOnly   xsd.Boolean `xml:"RectangleOnly,attr"`
+	SingleColorOnly xsd.Boolean `xml:"SingleColorOnly,attr"`
+	MaxMasks        int
+	MaxPoints       int
+	Types           []MaskType
+	Color           onvif.ColorOptions
+}
+
+//Media2 main types
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"tr2:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities Capabilities
+}
+
+type CreateProfile struct {
+	XMLName       string             `xml:"tr2:CreateProfile"`
+	Name          onvif.Name         `xml:"tr2:Name"`
+	Configuration []ConfigurationRef `xml:"tr2:Configuration"`
+}
+
+type CreateProfileResponse struct {
+	Token onvif.ReferenceToken
+}
+
+//GetProfiles returns the configurations of <Type> only, e.g. All
+type GetProfiles struct {
+	XMLName string                     `xml:"tr2:GetProfiles"`
+	Token   onvif.ReferenceToken       `xml:"tr2:Token,omitempty"`
+	Type    []ConfigurationEnumeration `xml:"tr2:Type"`
+}
+
+type GetProfilesResponse struct {
+	Profiles []MediaProfile
+}
+
+type AddConfiguration struct {
+	XMLName       string               `xml:"tr2:AddConfiguration"`
+	ProfileToken  onvif.ReferenceToken `xml:"tr2:ProfileToken"`
+	Name          onvif.Name           `xml:"tr2:Name,omitempty"`
+	Configuration []ConfigurationRef   `xml:"tr2:Configuration"`
+}
+
+type AddConfigurationResponse struct {
+}
+
+type RemoveConfiguration struct {
+	XMLName       string               `xml:"tr2:RemoveConfiguration"`
+	ProfileToken  onvif.ReferenceToken `xml:"tr2:ProfileToken"`
+	Configuration []ConfigurationRef   `xml:"tr2:Configuration"`
+}
+
+type RemoveConfigurationResponse struct {
+}
+
+type DeleteProfile struct {
+	XMLName string               `xml:"tr2:DeleteProfile"`
+	Token   onvif.ReferenceToken `xml:"tr2:Token"`
+}
+
+type DeleteProfileResponse struct {
+}
+
+type GetVideoEncoderConfigurations struct {
+	XMLName            string               `xml:"tr2:GetVideoEncoderConfigurations"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken,omitempty"`
+	ProfileToken       onvif.ReferenceToken `xml:"tr2:ProfileToken,omitempty"`
+}
+
+type GetVideoEncoderConfigurationsResponse struct {
+	Configurations []onvif.VideoEncoder2Configuration
+}
+
+type GetVideoSourceConfigurations struct {
+	XMLName            string               `xml:"tr2:GetVideoSourceConfigurations"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken,omitempty"`
+	ProfileToken       onvif.ReferenceToken `xml:"tr2:ProfileToken,omitempty"`
+}
+
+type GetVideoSourceConfigurationsResponse struct {
+	Configurations []onvif.VideoSourceConfiguration
+}
+
+type GetAudioEncoderConfigurations struct {
+	XMLName            string               `xml:"tr2:GetAudioEncoderConfigurations"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken,omitempty"`
+	ProfileToken       onvif.ReferenceToken `xml:"tr2:ProfileToken,omitempty"`
+}
+
+type GetAudioEncoderConfigurationsResponse struct {
+	Configurations []onvif.AudioEncoder2Configuration
+}
+
+type GetAudioSourceConfigurations struct {
+	XMLName            string               `xml:"tr2:GetAudioSourceConfigurations"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken,omitempty"`
+	ProfileToken       onvif.ReferenceToken `xml:"tr2:ProfileToken,omitempty"`
+}
+
+type GetAudioSourceConfigurationsResponse struct {
+	Configurations []onvif.AudioSourceConfiguration
+}
+
+type GetAnalyticsConfigurations struct {
+	XMLName            string               `xml:"tr2:GetAnalyticsConfigurations"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken,omitempty"`
+	ProfileToken       onvif.ReferenceToken `xml:"tr2:ProfileToken,omitempty"`
+}
+
+type GetAnalyticsConfigurationsResponse struct {
+	Configurations []onvif.VideoAnalyticsConfiguration
+}
+
+type GetMetadataConfigurations struct {
+	XMLName            string               `xml:"tr2:GetMetadataConfigurations"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken,omitempty"`
+	ProfileToken       onvif.ReferenceToken `xml:"tr2:ProfileToken,omitempty"`
+}
+
+type GetMetadataConfigurationsResponse struct {
+	Configurations []onvif.MetadataConfiguration
+}
+
+type GetAudioOutputConfigurations struct {
+	XMLName            string               `xml:"tr2:GetAudioOutputConfigurations"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken,omitempty"`
+	ProfileToken       onvif.ReferenceToken `xml:"tr2:ProfileToken,omitempty"`
+}
+
+type GetAudioOutputConfigurationsResponse struct {
+	Configurations []onvif.AudioOutputConfiguration
+}
+
+type GetAudioDecoderConfigurations struct {
+	XMLName            string               `xml:"tr2:GetAudioDecoderConfigurations"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken,omitempty"`
+	ProfileToken       onvif.ReferenceToken `xml:"tr2:ProfileToken,omitempty"`
+}
+
+type GetAudioDecoderConfigurationsResponse struct {
+	Configurations []onvif.AudioDecoderConfiguration
+}
+
+type SetVideoEncoderConfiguration struct {
+	XMLName       string                           `xml:"tr2:SetVideoEncoderConfiguration"`
+	Configuration onvif.VideoEncoder2Configuration `xml:"tr2:Configuration"`
+}
+
+type SetVideoEncoderConfigurationResponse struct {
+}
+
+type SetVideoSourceConfiguration struct {
+	XMLName       string                         `xml:"tr2:SetVideoSourceConfiguration"`
+	Configuration onvif.VideoSourceConfiguration `xml:"tr2:Configuration"`
+}
+
+type SetVideoSourceConfigurationResponse struct {
+}
+
+type SetAudioEncoderConfiguration struct {
+	XMLName       string                           `xml:"tr2:SetAudioEncoderConfiguration"`
+	Configuration onvif.AudioEncoder2Configuration `xml:"tr2:Configuration"`
+}
+
+type SetAudioEncoderConfigurationResponse struct {
+}
+
+type SetAudioSourceConfiguration struct {
+	XMLName       string                         `xml:"tr2:SetAudioSourceConfiguration"`
+	Configuration onvif.AudioSourceConfiguration `xml:"tr2:Configuration"`
+}
+
+type SetAudioSourceConfigurationResponse struct {
+}
+
+type SetMetadataConfiguration struct {
+	XMLName       string                      `xml:"tr2:SetMetadataConfiguration"`
+	Configuration onvif.MetadataConfiguration `xml:"tr2:Configuration"`
+}
+
+type SetMetadataConfigurationResponse struct {
+}
+
+type SetAudioOutputConfiguration struct {
+	XMLName       string                         `xml:"tr2:SetAudioOutputConfiguration"`
+	Configuration onvif.AudioOutputConfiguration `xml:"tr2:Configuration"`
+}
+
+type SetAudioOutputConfigurationResponse struct {
+}
+
+type SetAudioDecoderConfiguration struct {
+	XMLName       string                          `xml:"tr2:SetAudioDecoderConfiguration"`
+	Configuration onvif.AudioDecoderConfiguration `xml:"tr2:Configuration"`
+}
+
+type SetAudioDecoderConfigurationResponse struct {
+}
+
+type GetVideoSourceConfigurationOptions struct {
+	XMLName            string               `xml:"tr2:GetVideoSourceConfigurationOptions"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken,omitempty"`
+	ProfileToken       onvif.ReferenceToken `xml:"tr2:ProfileToken,omitempty"`
+}
+
+type GetVideoSourceConfigurationOptionsResponse struct {
+	Options onvif.VideoSourceConfigurationOptions
+}
+
+type GetVideoEncoderConfigurationOptions struct {
+	XMLName            string               `xml:"tr2:GetVideoEncoderConfigurationOptions"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken,omitempty"`
+	ProfileToken       onvif.ReferenceToken `xml:"tr2:ProfileToken,omitempty"`
+}
+
+type GetVideoEncoderConfigurationOptionsResponse struct {
+	Options []onvif.VideoEncoder2ConfigurationOptions
+}
+
+type GetAudioSourceConfigurationOptions struct {
+	XMLName            string               `xml:"tr2:GetAudioSourceConfigurationOptions"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken,omitempty"`
+	ProfileToken       onvif.ReferenceToken `xml:"tr2:ProfileToken,omitempty"`
+}
+
+type GetAudioSourceConfigurationOptionsResponse struct {
+	Options onvif.AudioSourceConfigurationOptions
+}
+
+type GetAudioEncoderConfigurationOptions struct {
+	XMLName            string               `xml:"tr2:GetAudioEncoderConfigurationOptions"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken,omitempty"`
+	ProfileToken       onvif.ReferenceToken `xml:"tr2:ProfileToken,omitempty"`
+}
+
+type GetAudioEncoderConfigurationOptionsResponse struct {
+	Options []onvif.AudioEncoder2ConfigurationOptions
+}
+
+type GetMetadataConfigurationOptions struct {
+	XMLName            string               `xml:"tr2:GetMetadataConfigurationOptions"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken,omitempty"`
+	ProfileToken       onvif.ReferenceToken `xml:"tr2:ProfileToken,omitempty"`
+}
+
+type GetMetadataConfigurationOptionsResponse struct {
+	Options onvif.MetadataConfigurationOptions
+}
+
+type GetAudioOutputConfigurationOptions struct {
+	XMLName            string               `xml:"tr2:GetAudioOutputConfigurationOptions"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken,omitempty"`
+	ProfileToken       onvif.ReferenceToken `xml:"tr2:ProfileToken,omitempty"`
+}
+
+type GetAudioOutputConfigurationOptionsResponse struct {
+	Options onvif.AudioOutputConfigurationOptions
+}
+
+type GetAudioDecoderConfigurationOptions struct {
+	XMLName            string               `xml:"tr2:GetAudioDecoderConfigurationOptions"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken,omitempty"`
+	ProfileToken       onvif.ReferenceToken `xml:"tr2:ProfileToken,omitempty"`
+}
+
+type GetAudioDecoderConfigurationOptionsResponse struct {
+	Options []onvif.AudioEncoder2ConfigurationOptions
+}
+
+type GetVideoEncoderInstances struct {
+	XMLName            string               `xml:"tr2:GetVideoEncoderInstances"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken"`
+}
+
+type GetVideoEncoderInstancesResponse struct {
+	Info EncoderInstanceInfo
+}
+
+type GetStreamUri struct {
+	XMLName      string               `xml:"tr2:GetStreamUri"`
+	Protocol     TransportProtocol    `xml:"tr2:Protocol"`
+	ProfileToken onvif.ReferenceToken `xml:"tr2:ProfileToken"`
+}
+
+type GetStreamUriResponse struct {
+	Uri xsd.AnyURI
+}
+
+type SetSynchronizationPoint struct {
+	XMLName      string               `xml:"tr2:SetSynchronizationPoint"`
+	ProfileToken onvif.ReferenceToken `xml:"tr2:ProfileToken"`
+}
+
+type SetSynchronizationPointResponse struct {
+}
+
+type GetSnapshotUri struct {
+	XMLName      string               `xml:"tr2:GetSnapshotUri"`
+	ProfileToken onvif.ReferenceToken `xml:"tr2:ProfileToken"`
+}
+
+type GetSnapshotUriResponse struct {
+	Uri xsd.AnyURI
+}
+
+type StartMulticastStreaming struct {
+	XMLName      string               `xml:"tr2:StartMulticastStreaming"`
+	ProfileToken onvif.ReferenceToken `xml:"tr2:ProfileToken"`
+}
+
+type StartMulticastStreamingResponse struct {
+}
+
+type StopMulticastStreaming struct {
+	XMLName      string               `xml:"tr2:StopMulticastStreaming"`
+	ProfileToken onvif.ReferenceToken `xml:"tr2:ProfileToken"`
+}
+
+type StopMulticastStreamingResponse struct {
+}
+
+type GetVideoSourceModes struct {
+	XMLName          string               `xml:"tr2:GetVideoSourceModes"`
+	VideoSourceToken onvif.ReferenceToken `xml:"tr2:VideoSourceToken"`
+}
+
+type GetVideoSourceModesResponse struct {
+	VideoSourceModes []VideoSourceMode
+}
+
+type SetVideoSourceMode struct {
+	XMLName              string               `xml:"tr2:SetVideoSourceMode"`
+	VideoSourceToken     onvif.ReferenceToken `xml:"tr2:VideoSourceToken"`
+	VideoSourceModeToken onvif.ReferenceToken `xml:"tr2:VideoSourceModeToken"`
+}
+
+type SetVideoSourceModeResponse struct {
+	Reboot xsd.Boolean
+}
+
+type GetOSDs struct {
+	XMLName            string               `xml:"tr2:GetOSDs"`
+	OSDToken           onvif.ReferenceToken `xml:"tr2:OSDToken,omitempty"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken,omitempty"`
+}
+
+type GetOSDsResponse struct {
+	OSDs []onvif.OSDConfiguration
+}
+
+type SetOSD struct {
+	XMLName string                 `xml:"tr2:SetOSD"`
+	OSD     onvif.OSDConfiguration `xml:"tr2:OSD"`
+}
+
+type SetOSDResponse struct {
+}
+
+type GetOSDOptions struct {
+	XMLName            string               `xml:"tr2:GetOSDOptions"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken"`
+}
+
+type GetOSDOptionsResponse struct {
+	OSDOptions onvif.OSDConfigurationOptions
+}
+
+type CreateOSD struct {
+	XMLName string                 `xml:"tr2:CreateOSD"`
+	OSD     onvif.OSDConfiguration `xml:"tr2:OSD"`
+}
+
+type CreateOSDResponse struct {
+	OSDToken onvif.ReferenceToken
+}
+
+type DeleteOSD struct {
+	XMLName  string               `xml:"tr2:DeleteOSD"`
+	OSDToken onvif.ReferenceToken `xml:"tr2:OSDToken"`
+}
+
+type DeleteOSDResponse struct {
+}
+
+type GetMasks struct {
+	XMLName            string               `xml:"tr2:GetMasks"`
+	Token              onvif.ReferenceToken `xml:"tr2:Token,omitempty"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken,omitempty"`
+}
+
+type GetMasksResponse struct {
+	Masks []Mask
+}
+
+type SetMask struct {
+	XMLName string `xml:"tr2:SetMask"`
+	Mask    Mask   `xml:"tr2:Mask"`
+}
+
+type SetMaskResponse struct {
+}
+
+type GetMaskOptions struct {
+	XMLName            string               `xml:"tr2:GetMaskOptions"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken"`
+}
+
+type GetMaskOptionsResponse struct {
+	Options MaskOptions
+}
+
+type CreateMask struct {
+	XMLName string `xml:"tr2:CreateMask"`
+	Mask    Mask   `xml:"tr2:Mask"`
+}
+
+type CreateMaskResponse struct {
+	Token onvif.ReferenceToken
+}
+
+type DeleteMask struct {
+	XMLName string               `xml:"tr2:DeleteMask"`
+	Token   onvif.ReferenceToken `xml:"tr2:Token"`
+}
+
+type DeleteMaskResponse struct {
+}
@@ -0,0 +1,277 @@
+package networking
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+/*************************
+	HTTP Digest (RFC 2617/7616)
+*************************/
+
+//DigestAuth HTTP Digest credentials of one device.
+//It caches the last challenge so following requests are authorized up front
+//instead of paying a 401 round trip every time
+type DigestAuth struct {
+	Username string
+	Password string
+
+	mutex      sync.Mutex
+	challenge  *digestChallenge
+	nonceCount uint32
+}
+
+type digestChallenge struct {
+	realm     string
+	nonce     string
+	opaque    string
+	algorithm string
+	qop       string
+	stale     bool
+}
+
+//NewDigestAuth returns digest credentials with an empty nonce cache
+func NewDigestAuth(username, password string) *DigestAuth {
+	return &DigestAuth{Username: username, Password: password}
+}
+
+//SendSoapDigestContext post message, answering a WWW-Authenticate: Digest challenge with <auth>
+func SendSoapDigestContext(ctx context.Context, endpoint, message string, auth *DigestAuth) (*http.Response, error) {
+	return SendSoapDigestWith(ctx, DefaultTransport, endpoint, message, auth)
+}
+
+//SendSoapDigestWith works like SendSoapDigestContext by <transport>, nil means DefaultTransport
+func SendSoapDigestWith(ctx context.Context, transport Transport, endpoint, message string, auth *DigestAuth) (*http.Response, error) {
+	if transport == nil {
+		transport = DefaultTransport
+	}
+
+	send := func() (*http.Response, error) {
+		req, err := newSoapRequest(ctx, endpoint, message)
+		if err != nil {
+			return nil, err
+		}
+		if authorization := auth.Authorization(req.Method, req.URL.RequestURI(), message); authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		return transport.Do(req)
+	}
+
+	resp, err := send()
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	//a stale or missing nonce gets one more try with the fresh challenge
+	if !auth.Update(resp.Header[http.CanonicalHeaderKey("WWW-Authenticate")]) {
+		return resp, nil
+	}
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+
+	return send()
+}
+
+func newSoapRequest(ctx context.Context, endpoint, message string) (*http.Request, error) {
+	req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewBufferString(message))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/soap+xml; charset=utf-8")
+	return req.WithContext(ctx), nil
+}
+
+//Update stores the strongest digest challenge of the WWW-Authenticate <headers>,
+//it reports whether the request should be sent again. It serves other protocols
+//with HTTP authentication too, e.g. RTSP
+func (auth *DigestAuth) Update(headers []string) bool {
+	var best *digestChallenge
+	for _, header := range headers {
+		challenge := parseDigestChallenge(header)
+		if challenge == nil || newDigestHash(challenge.algorithm) == nil {
+			continue
+		}
+		if best == nil || strings.HasPrefix(strings.ToUpper(challenge.algorithm), "SHA-256") {
+			best = challenge
+		}
+	}
+	if best == nil {
+		return false
+	}
+
+	auth.mutex.Lock()
+	defer auth.mutex.Unlock()
+
+	//same nonce and not stale means the credentials are wrong
+	retry := auth.challenge == nil || auth.challenge.nonce != best.nonce || best.stale
+	auth.challenge = best
+	auth.nonceCount = 0
+	return retry
+}
+
+//Authorization returns the Authorization header of the request <method> of <uri> with <body>,
+//empty when no challenge is cached
+func (auth *DigestAuth) Authorization(method, uri, body string) string {
+	auth.mutex.Lock()
+	challenge := auth.challenge
+	auth.nonceCount++
+	nonceCount := fmt.Sprintf("%08x", auth.nonceCount)
+	auth.mutex.Unlock()
+
+	if challenge == nil {
+		return ""
+	}
+
+	h := func(data string) string {
+		hasher := newDigestHash(challenge.algorithm)
+		io.WriteString(hasher, data)
+		return hex.EncodeToString(hasher.Sum(nil))
+	}
+
+	cnonce := newClientNonce()
+	qop := chooseQop(challenge.qop)
+
+	ha1 := h(auth.Username + ":" + challenge.realm + ":" + auth.Password)
+	if strings.HasSuffix(strings.ToLower(challenge.algorithm), "-sess") {
+		ha1 = h(ha1 + ":" + challenge.nonce + ":" + cnonce)
+	}
+	ha2 := h(method + ":" + uri)
+	if qop == "auth-int" {
+		ha2 = h(method + ":" + uri + ":" + h(body))
+	}
+
+	var response string
+	if qop == "" {
+		response = h(ha1 + ":" + challenge.nonce + ":" + ha2)
+	} else {
+		response = h(ha1 + ":" + challenge.nonce + ":" + nonceCount + ":" + cnonce + ":" + qop + ":" + ha2)
+	}
+
+	fields := []string{
+		fmt.Sprintf(`username="%s"`, auth.Username),
+		fmt.Sprintf(`realm="%s"`, challenge.realm),
+		fmt.Sprintf(`nonce="%s"`, challenge.nonce),
+		fmt.Sprintf(`uri="%s"`, uri),
+		fmt.Sprintf(`response="%s"`, response),
+	}
+	if challenge.algorithm != "" {
+		fields = append(fields, "algorithm="+challenge.algorithm)
+	}
+	if qop != "" {
+		fields = append(fields, "qop="+qop, "nc="+nonceCount, fmt.Sprintf(`cnonce="%s"`, cnonce))
+	}
+	if challenge.opaque != "" {
+		fields = append(fields, fmt.Sprintf(`opaque="%s"`, challenge.opaque))
+	}
+	return "Digest " + strings.Join(fields, ", ")
+}
+
+//parseDigestChallenge parses a WWW-Authenticate header, nil if it is not a Digest one
+func parseDigestChallenge(header string) *digestChallenge {
+	header = strings.TrimSpace(header)
+	if len(header) < 7 || !strings.EqualFold(header[:7], "Digest ") {
+		return nil
+	}
+
+	challenge := new(digestChallenge)
+	for key, value := range parseAuthParams(header[7:]) {
+		switch key {
+		case "realm":
+			challenge.realm = value
+		case "nonce":
+			challenge.nonce = value
+		case "opaque":
+			challenge.opaque = value
+		case "algorithm":
+			challenge.algorithm = value
+		case "qop":
+			challenge.qop = value
+		case "stale":
+			challenge.stale = strings.EqualFold(value, "true")
+		}
+	}
+	if challenge.nonce == "" {
+		return nil
+	}
+	return challenge
+}
+
+//parseAuthParams splits key=value, key="quoted, value" pairs
+func parseAuthParams(params string) map[string]string {
+	result := make(map[string]string)
+	for len(params) > 0 {
+		params = strings.TrimLeft(params, " ,\t")
+		eq := strings.IndexByte(params, '=')
+		if eq < 0 {
+			break
+		}
+		key := strings.ToLower(strings.TrimSpace(params[:eq]))
+		params = strings.TrimLeft(params[eq+1:], " \t")
+
+		var value string
+		if strings.HasPrefix(params, `"`) {
+			var buf strings.Builder
+			i := 1
+			for ; i < len(params) && params[i] != '"'; i++ {
+				if params[i] == '\\' && i+1 < len(params) {
+					i++
+				}
+				buf.WriteByte(params[i])
+			}
+			value = buf.String()
+			if i < len(params) {
+				i++
+			}
+			params = params[i:]
+		} else {
+			end := strings.IndexByte(params, ',')
+			if end < 0 {
+				end = len(params)
+			}
+			value = strings.TrimSpace(params[:end])
+			params = params[end:]
+		}
+		result[key] = value
+	}
+	return result
+}
+
+func newDigestHash(algorithm string) hash.Hash {
+	switch strings.ToUpper(algorithm) {
+	case "", "MD5", "MD5-SESS":
+		return md5.New()
+	case "SHA-256", "SHA-256-SESS":
+		return sha256.New()
+	}
+	return nil
+}
+
+//chooseQop prefers auth over auth-int, empty for RFC 2069 servers
+func chooseQop(offered string) string {
+	if offered == "" {
+		return ""
+	}
+	for _, qop := range strings.Split(offered, ",") {
+		if strings.TrimSpace(qop) == "auth" {
+			return "auth"
+		}
+	}
+	return "auth-int"
+}
+
+func newClientNonce() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
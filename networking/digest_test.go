@@ -0,0 +1,93 @@
+package networking
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+//mockedDigestServer accepts admin/123456 with the given algorithm and counts challenges
+func mockedDigestServer(algorithm string, newHash func() hash.Hash, challenges *int) *httptest.Server {
+	const nonce = "dcd98b7102dd2f0e8b11d0f600bfb0c093"
+	h := func(data string) string {
+		hasher := newHash()
+		hasher.Write([]byte(data))
+		return hex.EncodeToString(hasher.Sum(nil))
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		params := parseAuthParams(strings.TrimPrefix(r.Header.Get("Authorization"), "Digest "))
+		ha1 := h("admin:onvif:123456")
+		ha2 := h(r.Method + ":" + params["uri"])
+		expected := h(ha1 + ":" + nonce + ":" + params["nc"] + ":" + params["cnonce"] + ":auth:" + ha2)
+
+		if params["response"] == "" || params["response"] != expected {
+			*challenges++
+			w.Header().Add("WWW-Authenticate", `Digest realm="onvif", qop="auth", nonce="`+nonce+`", algorithm=`+algorithm)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func TestSendSoapDigest(t *testing.T) {
+
+	for algorithm, newHash := range map[string]func() hash.Hash{"MD5": md5.New, "SHA-256": sha256.New} {
+		challenges := 0
+		server := mockedDigestServer(algorithm, newHash, &challenges)
+
+		auth := NewDigestAuth("admin", "123456")
+		for i := 0; i < 3; i++ {
+			resp, err := SendSoapDigestContext(context.Background(), server.URL+"/onvif/device_service", "<Envelope/>", auth)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("%s: unexpected status %s", algorithm, resp.Status)
+			}
+		}
+		//the nonce is cached after the first challenge
+		if challenges != 1 {
+			t.Errorf("%s: %d challenges, expected 1", algorithm, challenges)
+		}
+		server.Close()
+	}
+}
+
+func TestSendSoapDigestWrongPassword(t *testing.T) {
+
+	challenges := 0
+	server := mockedDigestServer("MD5", md5.New, &challenges)
+	defer server.Close()
+
+	resp, err := SendSoapDigestContext(context.Background(), server.URL, "<Envelope/>", NewDigestAuth("admin", "wrong"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized || challenges != 2 {
+		t.Errorf("unexpected status %s after %d challenges", resp.Status, challenges)
+	}
+}
+
+func TestParseDigestChallenge(t *testing.T) {
+
+	challenge := parseDigestChallenge(`Digest realm="Login to 3K0\"1", qop="auth,auth-int", nonce="abc", opaque="x,y", stale=TRUE`)
+	if challenge == nil {
+		t.Fatal("challenge not parsed")
+	}
+	if challenge.realm != `Login to 3K0"1` || challenge.opaque != "x,y" || !challenge.stale || chooseQop(challenge.qop) != "auth" {
+		t.Errorf("unexpected challenge %#v", challenge)
+	}
+	if parseDigestChallenge(`Basic realm="onvif"`) != nil {
+		t.Error("basic challenge parsed as digest")
+	}
+}
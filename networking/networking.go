@@ -2,6 +2,7 @@ package networking
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"net/http"
 	"strconv"
@@ -12,9 +13,26 @@ import (
 
 //SendSoap message
 func SendSoap(endpoint, message string) (*http.Response, error) {
-	httpClient := new(http.Client)
+	return SendSoapContext(context.Background(), endpoint, message)
+}
+
+//SendSoapContext post message bound to ctx, the request is aborted once ctx is done
+func SendSoapContext(ctx context.Context, endpoint, message string) (*http.Response, error) {
+	return SendSoapWith(ctx, DefaultTransport, endpoint, message)
+}
+
+//SendSoapWith post message by <transport>, nil means DefaultTransport
+func SendSoapWith(ctx context.Context, transport Transport, endpoint, message string) (*http.Response, error) {
+	if transport == nil {
+		transport = DefaultTransport
+	}
+
+	req, err := newSoapRequest(ctx, endpoint, message)
+	if err != nil {
+		return nil, err
+	}
 
-	resp, err := httpClient.Post(endpoint, "application/soap+xml; charset=utf-8", bytes.NewBufferString(message))
+	resp, err := transport.Do(req)
 	if err != nil {
 		return resp, err
 	}
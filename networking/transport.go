@@ -0,0 +1,100 @@
+package networking
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+//Transport sends an http request, *http.Client satisfies it.
+//Implement it to add tracing, retries or a custom network stack
+type Transport interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+//TransportConfig options of NewTransport
+type TransportConfig struct {
+	//Timeout of a whole call including reading the body, 0 means no timeout
+	Timeout time.Duration
+	//DialTimeout of the tcp connection, 0 means 30s
+	DialTimeout time.Duration
+	//MaxIdleConnsPerHost kept alive for reuse, 0 means 4
+	MaxIdleConnsPerHost int
+	//IdleConnTimeout before an idle connection is closed, 0 means 90s
+	IdleConnTimeout time.Duration
+
+	//RootCAs verifying the device certificate, nil means the system pool
+	RootCAs *x509.CertPool
+	//Certificates presented when the device asks for a client certificate
+	Certificates []tls.Certificate
+	//InsecureSkipVerify accepts any device certificate, e.g. self-signed cameras
+	InsecureSkipVerify bool
+
+	//Proxy for all requests, nil means HTTP_PROXY/HTTPS_PROXY/NO_PROXY of the environment
+	Proxy *url.URL
+}
+
+//DefaultTransport shared by requests of devices without their own transport,
+//connections are pooled across calls
+var DefaultTransport = NewTransport(TransportConfig{})
+
+//NewTransport returns an http client configured by config
+func NewTransport(config TransportConfig) Transport {
+	if config.DialTimeout == 0 {
+		config.DialTimeout = 30 * time.Second
+	}
+	if config.MaxIdleConnsPerHost == 0 {
+		config.MaxIdleConnsPerHost = 4
+	}
+	if config.IdleConnTimeout == 0 {
+		config.IdleConnTimeout = 90 * time.Second
+	}
+
+	proxy := http.ProxyFromEnvironment
+	if config.Proxy != nil {
+		proxy = http.ProxyURL(config.Proxy)
+	}
+
+	transport := &http.Transport{
+		Proxy: proxy,
+		DialContext: (&net.Dialer{
+			Timeout:   config.DialTimeout,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		MaxIdleConns:          100,
+		MaxIdleConnsPerHost:   config.MaxIdleConnsPerHost,
+		IdleConnTimeout:       config.IdleConnTimeout,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+		TLSClientConfig: &tls.Config{
+			RootCAs:            config.RootCAs,
+			Certificates:       config.Certificates,
+			InsecureSkipVerify: config.InsecureSkipVerify,
+		},
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   config.Timeout,
+	}
+}
+
+//LoadCertPool reads PEM encoded CA certificates into a pool
+func LoadCertPool(caFiles ...string) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	for _, caFile := range caFiles {
+		pem, err := ioutil.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.New("no certificate found in " + caFile)
+		}
+	}
+	return pool, nil
+}
@@ -0,0 +1,114 @@
+package networking
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestNewTransport(t *testing.T) {
+
+	proxy, _ := url.Parse("http://proxy.local:3128")
+	pool := x509.NewCertPool()
+	client, ok := NewTransport(TransportConfig{
+		Timeout:             5 * time.Second,
+		MaxIdleConnsPerHost: 8,
+		RootCAs:             pool,
+		InsecureSkipVerify:  true,
+		Proxy:               proxy,
+	}).(*http.Client)
+	if !ok {
+		t.Fatal("transport is no http client")
+	}
+	transport := client.Transport.(*http.Transport)
+	if client.Timeout != 5*time.Second || transport.MaxIdleConnsPerHost != 8 || transport.IdleConnTimeout != 90*time.Second {
+		t.Errorf("unexpected pool settings %v %d %v", client.Timeout, transport.MaxIdleConnsPerHost, transport.IdleConnTimeout)
+	}
+	if transport.TLSClientConfig.RootCAs != pool || !transport.TLSClientConfig.InsecureSkipVerify {
+		t.Errorf("unexpected tls config %+v", transport.TLSClientConfig)
+	}
+	request, _ := http.NewRequest("POST", "http://192.168.0.10/onvif/device_service", nil)
+	if proxyURL, err := transport.Proxy(request); err != nil || proxyURL.String() != proxy.String() {
+		t.Errorf("unexpected proxy %v %v", proxyURL, err)
+	}
+
+	//the defaults
+	transport = NewTransport(TransportConfig{}).(*http.Client).Transport.(*http.Transport)
+	if transport.MaxIdleConnsPerHost != 4 || transport.TLSClientConfig.InsecureSkipVerify {
+		t.Errorf("unexpected default transport %+v", transport)
+	}
+}
+
+func TestTransportTLS(t *testing.T) {
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	//the device certificate is trusted by a CA file
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	data := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := ioutil.WriteFile(caFile, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	pool, err := LoadCertPool(caFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		config TransportConfig
+		valid  bool
+	}{
+		{TransportConfig{}, false},
+		{TransportConfig{RootCAs: pool}, true},
+		{TransportConfig{InsecureSkipVerify: true}, true},
+	} {
+		request, _ := http.NewRequest("GET", server.URL, nil)
+		resp, err := NewTransport(test.config).Do(request)
+		if err == nil {
+			resp.Body.Close()
+		}
+		if (err == nil) != test.valid {
+			t.Errorf("unexpected result %v of %+v", err, test.config)
+		}
+	}
+
+	if _, err = LoadCertPool(filepath.Join(t.TempDir(), "missing.pem")); !os.IsNotExist(err) {
+		t.Errorf("expected missing file, got %v", err)
+	}
+	if err = ioutil.WriteFile(caFile, []byte("no certificate"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = LoadCertPool(caFile); err == nil {
+		t.Error("file without certificate accepted")
+	}
+}
+
+func TestTransportProxy(t *testing.T) {
+
+	hosts := make(chan string, 1)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hosts <- r.URL.Host
+	}))
+	defer proxy.Close()
+
+	proxyURL, _ := url.Parse(proxy.URL)
+	request, _ := http.NewRequest("POST", "http://camera.invalid/onvif/device_service", nil)
+	resp, err := NewTransport(TransportConfig{Proxy: proxyURL}).Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if host := <-hosts; host != "camera.invalid" {
+		t.Errorf("unexpected host %s at the proxy", host)
+	}
+}
@@ -0,0 +1,93 @@
+package recording
+
+//go:generate go run ../cmd/clientgen -types types.go -package recording -client Client -out recording-client.go
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/use-go/goonvif"
+	"github.com/use-go/goonvif/xsd"
+	"github.com/use-go/goonvif/xsd/onvif"
+)
+
+//SourceTypeProfile source type of a recording job recording a media profile
+const SourceTypeProfile = xsd.AnyURI("http://www.onvif.org/ver10/schema/Profile")
+
+//Client typed client of the ONVIF Recording service,
+//its methods are generated from types.go by cmd/clientgen
+type Client struct {
+	dev *goonvif.Device
+}
+
+//NewClient returns a Recording service client of dev
+func NewClient(dev *goonvif.Device) *Client {
+	return &Client{dev: dev}
+}
+
+//ContinuousRecording arguments of SetupContinuousRecording
+type ContinuousRecording struct {
+	//ProfileToken of the recorded media profile
+	ProfileToken onvif.ReferenceToken
+	//RecordingToken of the recording written by the job, a new recording of Configuration is created when empty
+	RecordingToken onvif.RecordingReference
+	//Configuration of the created recording
+	Configuration onvif.RecordingConfiguration
+	//Priority of the job, 1 when 0
+	Priority int
+}
+
+//RecordingJob a recording job set up by SetupContinuousRecording
+type RecordingJob struct {
+	RecordingToken onvif.RecordingReference
+	JobToken       onvif.RecordingJobRefence
+	//CreatedRecording whether the recording was created for the job
+	CreatedRecording bool
+}
+
+//SetupContinuousRecording creates an active recording job recording the media profile of <recording>
+//into its recording, created first when it has no token. A recording created by this call is deleted
+//again when the job can not be created, an error of the delete is joined to the returned error
+func (c *Client) SetupContinuousRecording(ctx context.Context, recording ContinuousRecording) (*RecordingJob, error) {
+	if recording.ProfileToken == "" {
+		return nil, errors.New("continuous recording without profile token")
+	}
+	job := &RecordingJob{RecordingToken: recording.RecordingToken}
+	if job.RecordingToken == "" {
+		created, err := c.CreateRecording(ctx, CreateRecording{RecordingConfiguration: recording.Configuration})
+		if err != nil {
+			return nil, err
+		}
+		job.RecordingToken = onvif.RecordingReference(strings.TrimSpace(string(created.RecordingToken)))
+		job.CreatedRecording = true
+	}
+
+	priority := recording.Priority
+	if priority <= 0 {
+		priority = 1
+	}
+	created, err := c.CreateRecordingJob(ctx, CreateRecordingJob{JobConfiguration: onvif.RecordingJobConfiguration{
+		RecordingToken: job.RecordingToken,
+		Mode:           onvif.RecordingJobModeActive,
+		Priority:       xsd.Int(priority),
+		Source: []onvif.RecordingJobSource{{
+			SourceToken: &onvif.SourceReference{Type: SourceTypeProfile, Token: recording.ProfileToken},
+		}},
+	}})
+	if err != nil {
+		if job.CreatedRecording {
+			//the failure may come from ctx, the recording is deleted under a context of its own
+			deleteCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			_, deleteErr := c.DeleteRecording(deleteCtx, DeleteRecording{RecordingToken: job.RecordingToken})
+			cancel()
+			if deleteErr != nil {
+				err = errors.Join(err, deleteErr)
+			}
+		}
+		return nil, err
+	}
+	job.JobToken = onvif.RecordingJobRefence(strings.TrimSpace(string(created.JobToken)))
+	return job, nil
+}
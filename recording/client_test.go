@@ -0,0 +1,116 @@
+package recording
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/use-go/goonvif"
+	"github.com/use-go/goonvif/gosoap"
+)
+
+const recordingEnvelope = `<SOAP-ENV:Envelope xmlns:SOAP-ENV="http://www.w3.org/2003/05/soap-envelope" xmlns:tt="http://www.onvif.org/ver10/schema"
+	xmlns:tds="http://www.onvif.org/ver10/device/wsdl" xmlns:trc="http://www.onvif.org/ver10/recording/wsdl"
+	xmlns:ter="http://www.onvif.org/ver10/error"><SOAP-ENV:Body>%s</SOAP-ENV:Body></SOAP-ENV:Envelope>`
+
+//mockedRecordingDevice creates the recording Recording_1 and the recording job Job_1 of Profile_1,
+//the job of Profile_2 fails and the one of Profile_3 is too slow. The deleted recordings are sent on <deletes>
+func mockedRecordingDevice(deletes chan<- string) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		request := string(data)
+		body := ""
+		switch {
+		case strings.Contains(request, "GetServices"):
+			body = `<tds:GetServicesResponse><tds:Service><tds:Namespace>http://www.onvif.org/ver10/recording/wsdl</tds:Namespace>
+				<tds:XAddr>` + server.URL + `/onvif/recording</tds:XAddr></tds:Service></tds:GetServicesResponse>`
+		case strings.Contains(request, "CreateRecordingJob") && strings.Contains(request, ">Profile_1<"):
+			body = `<trc:CreateRecordingJobResponse><trc:JobToken>Job_1</trc:JobToken></trc:CreateRecordingJobResponse>`
+		case strings.Contains(request, "CreateRecordingJob") && strings.Contains(request, ">Profile_3<"):
+			time.Sleep(200 * time.Millisecond)
+			body = `<trc:CreateRecordingJobResponse><trc:JobToken>Job_3</trc:JobToken></trc:CreateRecordingJobResponse>`
+		case strings.Contains(request, "CreateRecordingJob"):
+			w.WriteHeader(http.StatusInternalServerError)
+			body = `<SOAP-ENV:Fault><SOAP-ENV:Code><SOAP-ENV:Value>SOAP-ENV:Receiver</SOAP-ENV:Value>
+				<SOAP-ENV:Subcode><SOAP-ENV:Value>ter:Action</SOAP-ENV:Value>
+				<SOAP-ENV:Subcode><SOAP-ENV:Value>ter:MaxRecordingJobs</SOAP-ENV:Value></SOAP-ENV:Subcode></SOAP-ENV:Subcode></SOAP-ENV:Code>
+				<SOAP-ENV:Reason><SOAP-ENV:Text xml:lang="en">No more recording jobs</SOAP-ENV:Text></SOAP-ENV:Reason></SOAP-ENV:Fault>`
+		case strings.Contains(request, "CreateRecording"):
+			body = `<trc:CreateRecordingResponse><trc:RecordingToken>Recording_1</trc:RecordingToken></trc:CreateRecordingResponse>`
+		case strings.Contains(request, "DeleteRecording"):
+			deletes <- request
+			body = `<trc:DeleteRecordingResponse/>`
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		fmt.Fprintf(w, recordingEnvelope, body)
+	}))
+	return server
+}
+
+func TestSetupContinuousRecording(t *testing.T) {
+
+	deletes := make(chan string, 4)
+	server := mockedRecordingDevice(deletes)
+	defer server.Close()
+
+	dev, err := goonvif.NewDevice(strings.TrimPrefix(server.URL, "http://"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := NewClient(dev)
+	ctx := context.Background()
+
+	job, err := client.SetupContinuousRecording(ctx, ContinuousRecording{ProfileToken: "Profile_1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.RecordingToken != "Recording_1" || job.JobToken != "Job_1" || !job.CreatedRecording {
+		t.Errorf("unexpected job %+v", job)
+	}
+
+	//the recording created for the failed job is deleted again
+	job, err = client.SetupContinuousRecording(ctx, ContinuousRecording{ProfileToken: "Profile_2"})
+	var fault *gosoap.Fault
+	if job != nil || !errors.As(err, &fault) || !fault.HasSubcode("ter:MaxRecordingJobs") {
+		t.Fatalf("expected fault of the job, got %+v %v", job, err)
+	}
+	select {
+	case request := <-deletes:
+		if !strings.Contains(request, "<trc:RecordingToken>Recording_1</trc:RecordingToken>") {
+			t.Errorf("unexpected delete %s", request)
+		}
+	default:
+		t.Error("created recording not deleted")
+	}
+
+	//the recording is deleted after the deadline of the setup as well
+	timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	if _, err = client.SetupContinuousRecording(timeoutCtx, ContinuousRecording{ProfileToken: "Profile_3"}); err == nil {
+		t.Error("job after the deadline accepted")
+	}
+	select {
+	case <-deletes:
+	default:
+		t.Error("recording created before the deadline not deleted")
+	}
+
+	//a recording of the caller is kept
+	if _, err = client.SetupContinuousRecording(ctx, ContinuousRecording{ProfileToken: "Profile_2", RecordingToken: "Recording_2"}); err == nil {
+		t.Error("failed job accepted")
+	}
+	select {
+	case request := <-deletes:
+		t.Errorf("recording of the caller deleted by %s", request)
+	default:
+	}
+}
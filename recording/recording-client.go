@@ -0,0 +1,124 @@
+// Code generated by clientgen from recording/types.go; DO NOT EDIT.
+
+package recording
+
+import (
+	"context"
+)
+
+//CreateRecording calls trc:CreateRecording and returns its response
+func (c *Client) CreateRecording(ctx context.Context, request CreateRecording) (*CreateRecordingResponse, error) {
+	var response CreateRecordingResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//CreateRecordingJob calls trc:CreateRecordingJob and returns its response
+func (c *Client) CreateRecordingJob(ctx context.Context, request CreateRecordingJob) (*CreateRecordingJobResponse, error) {
+	var response CreateRecordingJobResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//CreateTrack calls trc:CreateTrack and returns its response
+func (c *Client) CreateTrack(ctx context.Context, request CreateTrack) (*CreateTrackResponse, error) {
+	var response CreateTrackResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//DeleteRecording calls trc:DeleteRecording and returns its response
+func (c *Client) DeleteRecording(ctx context.Context, request DeleteRecording) (*DeleteRecordingResponse, error) {
+	var response DeleteRecordingResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//DeleteRecordingJob calls trc:DeleteRecordingJob and returns its response
+func (c *Client) DeleteRecordingJob(ctx context.Context, request DeleteRecordingJob) (*DeleteRecordingJobResponse, error) {
+	var response DeleteRecordingJobResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//DeleteTrack calls trc:DeleteTrack and returns its response
+func (c *Client) DeleteTrack(ctx context.Context, request DeleteTrack) (*DeleteTrackResponse, error) {
+	var response DeleteTrackResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//ExportRecordedData calls trc:ExportRecordedData and returns its response
+func (c *Client) ExportRecordedData(ctx context.Context, request ExportRecordedData) (*ExportRecordedDataResponse, error) {
+	var response ExportRecordedDataResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetRecordingJobState calls trc:GetRecordingJobState and returns its response
+func (c *Client) GetRecordingJobState(ctx context.Context, request GetRecordingJobState) (*GetRecordingJobStateResponse, error) {
+	var response GetRecordingJobStateResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetRecordingJobs calls trc:GetRecordingJobs and returns its response
+func (c *Client) GetRecordingJobs(ctx context.Context, request GetRecordingJobs) (*GetRecordingJobsResponse, error) {
+	var response GetRecordingJobsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetRecordingOptions calls trc:GetRecordingOptions and returns its response
+func (c *Client) GetRecordingOptions(ctx context.Context, request GetRecordingOptions) (*GetRecordingOptionsResponse, error) {
+	var response GetRecordingOptionsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetRecordings calls trc:GetRecordings and returns its response
+func (c *Client) GetRecordings(ctx context.Context, request GetRecordings) (*GetRecordingsResponse, error) {
+	var response GetRecordingsResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetServiceCapabilities calls trc:GetServiceCapabilities and returns its response
+func (c *Client) GetServiceCapabilities(ctx context.Context, request GetServiceCapabilities) (*GetServiceCapabilitiesResponse, error) {
+	var response GetServiceCapabilitiesResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetRecordingJobMode calls trc:SetRecordingJobMode and returns its response
+func (c *Client) SetRecordingJobMode(ctx context.Context, request SetRecordingJobMode) (*SetRecordingJobModeResponse, error) {
+	var response SetRecordingJobModeResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
@@ -44,3 +44,163 @@ type GetRecordingJobsResponse struct {
 		JobToken onvif.RecordingJobRefence
 	}
 }
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"trc:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities Capabilities
+}
+
+type Capabilities struct {
+	DynamicRecordings          xsd.Boolean          `xml:"DynamicRecordings,attr"`
+	DynamicTracks              xsd.Boolean          `xml:"DynamicTracks,attr"`
+	Encoding                   onvif.StringAttrList `xml:"Encoding,attr"`
+	MaxRate                    xsd.Float            `xml:"MaxRate,attr"`
+	MaxTotalRate               xsd.Float            `xml:"MaxTotalRate,attr"`
+	MaxRecordings              xsd.Float            `xml:"MaxRecordings,attr"`
+	MaxRecordingJobs           xsd.Int              `xml:"MaxRecordingJobs,attr"`
+	Options                    xsd.Boolean          `xml:"Options,attr"`
+	MetadataRecording          xsd.Boolean          `xml:"MetadataRecording,attr"`
+	SupportedExportFileFormats onvif.StringAttrList `xml:"SupportedExportFileFormats,attr"`
+}
+
+type CreateRecording struct {
+	XMLName                string                       `xml:"trc:CreateRecording"`
+	RecordingConfiguration onvif.RecordingConfiguration `xml:"trc:RecordingConfiguration"`
+}
+
+type CreateRecordingResponse struct {
+	RecordingToken onvif.RecordingReference
+}
+
+type DeleteRecording struct {
+	XMLName        string                   `xml:"trc:DeleteRecording"`
+	RecordingToken onvif.RecordingReference `xml:"trc:RecordingToken"`
+}
+
+type DeleteRecordingResponse struct{}
+
+type CreateTrack struct {
+	XMLName            string                   `xml:"trc:CreateTrack"`
+	RecordingToken     onvif.RecordingReference `xml:"trc:RecordingToken"`
+	TrackConfiguration onvif.TrackConfiguration `xml:"trc:TrackConfiguration"`
+}
+
+type CreateTrackResponse struct {
+	TrackToken onvif.TrackReference
+}
+
+type DeleteTrack struct {
+	XMLName        string                   `xml:"trc:DeleteTrack"`
+	RecordingToken onvif.RecordingReference `xml:"trc:RecordingToken"`
+	TrackToken     onvif.TrackReference     `xml:"trc:TrackToken"`
+}
+
+type DeleteTrackResponse struct{}
+
+type CreateRecordingJob struct {
+	XMLName          string                          `xml:"trc:CreateRecordingJob"`
+	JobConfiguration onvif.RecordingJobConfiguration `xml:"trc:JobConfiguration"`
+}
+
+type CreateRecordingJobResponse struct {
+	JobToken         onvif.RecordingJobRefence
+	JobConfiguration RecordingJobConfiguration
+}
+
+type RecordingJobConfiguration struct {
+	RecordingToken onvif.RecordingReference
+	Mode           onvif.RecordingJobMode
+	Priority       xsd.Int
+	Source         []struct {
+		SourceToken struct {
+			Type  xsd.AnyURI `xml:"Type,attr"`
+			Token onvif.ReferenceToken
+		}
+		AutoCreateReceiver xsd.Boolean
+		Tracks             []struct {
+			SourceTag   string
+			Destination onvif.TrackReference
+		}
+	}
+}
+
+type DeleteRecordingJob struct {
+	XMLName  string                    `xml:"trc:DeleteRecordingJob"`
+	JobToken onvif.RecordingJobRefence `xml:"trc:JobToken"`
+}
+
+type DeleteRecordingJobResponse struct{}
+
+type SetRecordingJobMode struct {
+	XMLName  string                    `xml:"trc:SetRecordingJobMode"`
+	JobToken onvif.RecordingJobRefence `xml:"trc:JobToken"`
+	Mode     onvif.RecordingJobMode    `xml:"trc:Mode"`
+}
+
+type SetRecordingJobModeResponse struct{}
+
+type GetRecordingJobState struct {
+	XMLName  string                    `xml:"trc:GetRecordingJobState"`
+	JobToken onvif.RecordingJobRefence `xml:"trc:JobToken"`
+}
+
+type GetRecordingJobStateResponse struct {
+	State RecordingJobStateInformation
+}
+
+type RecordingJobStateInformation struct {
+	RecordingToken onvif.RecordingReference
+	State          string
+	Sources        []struct {
+		SourceToken struct {
+			Type  xsd.AnyURI `xml:"Type,attr"`
+			Token onvif.ReferenceToken
+		}
+		State  string
+		Tracks struct {
+			Track []struct {
+				SourceTag   string
+				Destination onvif.TrackReference
+				Error       string
+				State       string
+			}
+		}
+	}
+}
+
+type GetRecordingOptions struct {
+	XMLName        string                   `xml:"trc:GetRecordingOptions"`
+	RecordingToken onvif.RecordingReference `xml:"trc:RecordingToken"`
+}
+
+type GetRecordingOptionsResponse struct {
+	Options struct {
+		Job struct {
+			Spare             xsd.Int              `xml:"Spare,attr"`
+			CompatibleSources onvif.StringAttrList `xml:"CompatibleSources,attr"`
+		}
+		Track struct {
+			SpareTotal    xsd.Int `xml:"SpareTotal,attr"`
+			SpareVideo    xsd.Int `xml:"SpareVideo,attr"`
+			SpareAudio    xsd.Int `xml:"SpareAudio,attr"`
+			SpareMetadata xsd.Int `xml:"SpareMetadata,attr"`
+		}
+	}
+}
+
+type ExportRecordedData struct {
+	XMLName            string                     `xml:"trc:ExportRecordedData"`
+	StartPoint         xsd.DateTime               `xml:"trc:StartPoint,omitempty"`
+	EndPoint           xsd.DateTime               `xml:"trc:EndPoint,omitempty"`
+	SearchScope        onvif.SearchScope          `xml:"trc:SearchScope"`
+	FileFormat         string                     `xml:"trc:FileFormat"`
+	StorageDestination onvif.StorageReferencePath `xml:"trc:StorageDestination"`
+}
+
+type ExportRecordedDataResponse struct {
+	OperationToken onvif.ReferenceToken
+	FileNames      []string
+}
@@ -0,0 +1,76 @@
+package replay
+
+//go:generate go run ../cmd/clientgen -types types.go -package replay -client Client -out replay-client.go
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/use-go/goonvif"
+	"github.com/use-go/goonvif/xsd/onvif"
+)
+
+//Stream setup of the replay uri, ONVIF replays RTP over RTSP
+const (
+	StreamTypeRTPUnicast  = onvif.StreamType("RTP-Unicast")
+	TransportProtocolRTSP = onvif.TransportProtocol("RTSP")
+)
+
+//Client typed client of the ONVIF Replay service,
+//its methods are generated from types.go by cmd/clientgen
+type Client struct {
+	dev *goonvif.Device
+}
+
+//NewClient returns a Replay service client of dev
+func NewClient(dev *goonvif.Device) *Client {
+	return &Client{dev: dev}
+}
+
+//ReplayUri returns the RTSP uri replaying the recording of <recordingToken>
+func (c *Client) ReplayUri(ctx context.Context, recordingToken onvif.ReferenceToken) (string, error) {
+	response, err := c.GetReplayUri(ctx, GetReplayUri{
+		StreamSetup: onvif.StreamSetup{
+			Stream:    StreamTypeRTPUnicast,
+			Transport: onvif.Transport{Protocol: TransportProtocolRTSP},
+		},
+		RecordingToken: recordingToken,
+	})
+	if err != nil {
+		return "", err
+	}
+	uri := strings.TrimSpace(string(response.Uri))
+	if uri == "" {
+		return "", errors.New("GetReplayUri returned no uri")
+	}
+	return uri, nil
+}
+
+//OpenReplay connects to the replay uri of <recordingToken> with the credentials of the device
+//and sets up all tracks, the replay starts by Play of the returned session. Play in reverse
+//fails with ErrReversePlayback when the device has no ReversePlayback capability
+func (c *Client) OpenReplay(ctx context.Context, recordingToken onvif.ReferenceToken) (*RTSPSession, error) {
+	capabilities, err := c.GetServiceCapabilities(ctx, GetServiceCapabilities{})
+	if err != nil {
+		return nil, err
+	}
+	uri, err := c.ReplayUri(ctx, recordingToken)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := DialRTSP(ctx, uri, c.dev.GetUser(), c.dev.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+	session.noReverse = !bool(capabilities.Capabilities.ReversePlayback)
+	if _, err = session.Describe(ctx); err == nil {
+		err = session.Setup(ctx)
+	}
+	if err != nil {
+		session.Close()
+		return nil, err
+	}
+	return session, nil
+}
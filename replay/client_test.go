@@ -0,0 +1,69 @@
+package replay
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	"github.com/use-go/goonvif"
+)
+
+const replayEnvelope = `<SOAP-ENV:Envelope xmlns:SOAP-ENV="http://www.w3.org/2003/05/soap-envelope" xmlns:tt="http://www.onvif.org/ver10/schema"
+	xmlns:tds="http://www.onvif.org/ver10/device/wsdl" xmlns:trp="http://www.onvif.org/ver10/replay/wsdl"><SOAP-ENV:Body>%s</SOAP-ENV:Body></SOAP-ENV:Envelope>`
+
+func TestOpenReplay(t *testing.T) {
+
+	requests := make(chan textproto.MIMEHeader, 16)
+	listener := fakeRTSPServer(t, requests)
+	defer listener.Close()
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		request := string(data)
+		body := ""
+		switch {
+		case strings.Contains(request, "GetServices"):
+			body = `<tds:GetServicesResponse><tds:Service><tds:Namespace>http://www.onvif.org/ver10/replay/wsdl</tds:Namespace>
+				<tds:XAddr>` + server.URL + `/onvif/replay</tds:XAddr></tds:Service></tds:GetServicesResponse>`
+		case strings.Contains(request, "GetServiceCapabilities"):
+			body = `<trp:GetServiceCapabilitiesResponse><trp:Capabilities ReversePlayback="true" SessionTimeoutRange="10 3600" RTP_RTSP_TCP="true"/>
+				</trp:GetServiceCapabilitiesResponse>`
+		case strings.Contains(request, "GetReplayUri"):
+			body = `<trp:GetReplayUriResponse><trp:Uri>rtsp://` + listener.Addr().String() + `/recording</trp:Uri></trp:GetReplayUriResponse>`
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		fmt.Fprintf(w, replayEnvelope, body)
+	}))
+	defer server.Close()
+
+	dev, err := goonvif.NewDeviceWithAuth(strings.TrimPrefix(server.URL, "http://"), "admin", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := NewClient(dev)
+	ctx := context.Background()
+	capabilities, err := client.GetServiceCapabilities(ctx, GetServiceCapabilities{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if timeouts := capabilities.Capabilities.SessionTimeoutRange.FloatAttrList; len(timeouts) != 2 || timeouts[0] != 10 || timeouts[1] != 3600 {
+		t.Errorf("unexpected session timeout range %v", timeouts)
+	}
+
+	session, err := client.OpenReplay(ctx, "Recording_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer session.Close()
+	if session.noReverse || len(session.Tracks()) != 2 {
+		t.Errorf("unexpected session, reverse playback %v, tracks %v", !session.noReverse, session.Tracks())
+	}
+}
@@ -0,0 +1,64 @@
+package replay
+
+import (
+	"strconv"
+	"time"
+)
+
+//Frames values of the Frames header
+const (
+	FramesAll       = "all"
+	FramesIntra     = "intra"
+	FramesPredicted = "predicted"
+)
+
+//clockLayout of the clock ranges, e.g. 20090615T114900.440Z
+const clockLayout = "20060102T150405.000Z"
+
+//Playback PLAY request of an ONVIF replay session (ONVIF Streaming Specification, Playback):
+//
+//	session.Play(ctx, replay.Playback{From: from, Scale: -2})
+type Playback struct {
+	//From replay position, the current position when zero
+	From time.Time
+	//To end of the replay, the end of the recording when zero. In reverse To is before From
+	To time.Time
+	//Scale of the replay speed, negative values replay in reverse, 1 when 0
+	Scale float64
+	//NoRateControl replays as fast as the client reads instead of in real time
+	NoRateControl bool
+	//Immediate replays from From at once instead of after the data of the previous PLAY
+	Immediate bool
+	//Frames replayed, FramesIntra optionally with the minimum interval in ms, e.g. intra/4000
+	Frames string
+}
+
+//Header returns the RTSP headers of the PLAY request
+func (playback Playback) Header() map[string]string {
+	header := map[string]string{"Require": "onvif-replay"}
+	if !playback.From.IsZero() {
+		clock := "clock=" + playback.From.UTC().Format(clockLayout) + "-"
+		if !playback.To.IsZero() {
+			clock += playback.To.UTC().Format(clockLayout)
+		}
+		header["Range"] = clock
+	}
+	if playback.Scale != 0 && playback.Scale != 1 {
+		header["Scale"] = strconv.FormatFloat(playback.Scale, 'f', -1, 64)
+	}
+	if playback.NoRateControl {
+		header["Rate-Control"] = "no"
+	}
+	if playback.Immediate {
+		header["Immediate"] = "yes"
+	}
+	if playback.Frames != "" {
+		header["Frames"] = playback.Frames
+	}
+	return header
+}
+
+//Reverse whether the playback replays in reverse
+func (playback Playback) Reverse() bool {
+	return playback.Scale < 0
+}
@@ -0,0 +1,43 @@
+// Code generated by clientgen from replay/types.go; DO NOT EDIT.
+
+package replay
+
+import (
+	"context"
+)
+
+//GetReplayConfiguration calls trp:GetReplayConfiguration and returns its response
+func (c *Client) GetReplayConfiguration(ctx context.Context, request GetReplayConfiguration) (*GetReplayConfigurationResponse, error) {
+	var response GetReplayConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetReplayUri calls trp:GetReplayUri and returns its response
+func (c *Client) GetReplayUri(ctx context.Context, request GetReplayUri) (*GetReplayUriResponse, error) {
+	var response GetReplayUriResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//GetServiceCapabilities calls trp:GetServiceCapabilities and returns its response
+func (c *Client) GetServiceCapabilities(ctx context.Context, request GetServiceCapabilities) (*GetServiceCapabilitiesResponse, error) {
+	var response GetServiceCapabilitiesResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+//SetReplayConfiguration calls trp:SetReplayConfiguration and returns its response
+func (c *Client) SetReplayConfiguration(ctx context.Context, request SetReplayConfiguration) (*SetReplayConfigurationResponse, error) {
+	var response SetReplayConfigurationResponse
+	if err := c.dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
@@ -0,0 +1,371 @@
+package replay
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/textproto"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/use-go/goonvif/networking"
+)
+
+//ErrReversePlayback returned by Play when the device does not replay in reverse
+var ErrReversePlayback = errors.New("device does not support reverse playback")
+
+//RTSPResponse response of an RTSP request
+type RTSPResponse struct {
+	StatusCode int
+	Status     string
+	Header     textproto.MIMEHeader
+	Body       []byte
+}
+
+//RTSPSession ONVIF replay session over RTSP, the media is interleaved on the RTSP connection.
+//The requests and ReadPacket must not be called concurrently
+type RTSPSession struct {
+	//UserAgent header of the requests
+	UserAgent string
+	//Timeout of a request, 10s when 0
+	Timeout time.Duration
+
+	uri                string
+	username, password string
+	conn               net.Conn
+	reader             *bufio.Reader
+	cseq               int
+	session            string
+	digest             *networking.DigestAuth
+	basic              bool
+	sdp                []byte
+	aggregate          string
+	tracks             []string
+	noReverse          bool
+}
+
+//DialRTSP connects to the RTSP server of the replay <uri>, the user info of <uri> is
+//overridden by non empty <username> and <password>
+func DialRTSP(ctx context.Context, uri, username, password string) (*RTSPSession, error) {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return nil, err
+	}
+	if parsed.Scheme != "rtsp" {
+		return nil, errors.New("no rtsp uri: " + uri)
+	}
+	if parsed.User != nil && username == "" {
+		username = parsed.User.Username()
+		password, _ = parsed.User.Password()
+	}
+	parsed.User = nil
+
+	address := parsed.Host
+	if parsed.Port() == "" {
+		address = net.JoinHostPort(parsed.Hostname(), "554")
+	}
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	return &RTSPSession{
+		uri:      parsed.String(),
+		username: username,
+		password: password,
+		digest:   networking.NewDigestAuth(username, password),
+		conn:     conn,
+		reader:   bufio.NewReader(conn),
+	}, nil
+}
+
+//URI returns the replay uri without user info
+func (s *RTSPSession) URI() string {
+	return s.uri
+}
+
+//SDP returns the session description of Describe
+func (s *RTSPSession) SDP() []byte {
+	return s.sdp
+}
+
+//Tracks returns the control uris of the media of Describe
+func (s *RTSPSession) Tracks() []string {
+	return s.tracks
+}
+
+//Describe requests the session description of the recording
+func (s *RTSPSession) Describe(ctx context.Context) (*RTSPResponse, error) {
+	response, err := s.Do(ctx, "DESCRIBE", s.uri, map[string]string{"Accept": "application/sdp"})
+	if err != nil {
+		return nil, err
+	}
+	base := s.uri
+	if contentBase := response.Header.Get("Content-Base"); contentBase != "" {
+		base = contentBase
+	}
+	s.sdp = response.Body
+	s.aggregate, s.tracks = sdpControls(response.Body, base)
+	return response, nil
+}
+
+//Setup sets up every track of Describe, RTP/RTCP of the n-th track is interleaved on the channels 2n and 2n+1
+func (s *RTSPSession) Setup(ctx context.Context) error {
+	if len(s.tracks) == 0 {
+		return errors.New("no tracks to set up, Describe first")
+	}
+	for i, track := range s.tracks {
+		header := map[string]string{"Transport": fmt.Sprintf("RTP/AVP/TCP;unicast;interleaved=%d-%d", 2*i, 2*i+1)}
+		if _, err := s.Do(ctx, "SETUP", track, header); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//Play starts or repositions the replay
+func (s *RTSPSession) Play(ctx context.Context, playback Playback) (*RTSPResponse, error) {
+	if playback.Reverse() && s.noReverse {
+		return nil, ErrReversePlayback
+	}
+	return s.Do(ctx, "PLAY", s.aggregate, playback.Header())
+}
+
+//Pause pauses the replay, Play with a zero From continues at the paused position
+func (s *RTSPSession) Pause(ctx context.Context) error {
+	_, err := s.Do(ctx, "PAUSE", s.aggregate, nil)
+	return err
+}
+
+//Teardown ends the replay session on the server
+func (s *RTSPSession) Teardown(ctx context.Context) error {
+	if s.session == "" {
+		return nil
+	}
+	_, err := s.Do(ctx, "TEARDOWN", s.aggregate, nil)
+	s.session = ""
+	return err
+}
+
+//Close tears the session down and closes the connection
+func (s *RTSPSession) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout())
+	defer cancel()
+	s.Teardown(ctx)
+	return s.conn.Close()
+}
+
+//ReadPacket returns the next interleaved RTP or RTCP packet with its channel,
+//RTSP messages of the server in between are skipped
+func (s *RTSPSession) ReadPacket() (byte, []byte, error) {
+	s.conn.SetReadDeadline(time.Time{})
+	for {
+		first, err := s.reader.Peek(1)
+		if err != nil {
+			return 0, nil, err
+		}
+		if first[0] == '$' {
+			return s.readInterleaved()
+		}
+		if _, err = s.readResponse(); err != nil {
+			return 0, nil, err
+		}
+	}
+}
+
+//Do sends the request <method> of <uri> with <header>, adding CSeq, Session, Require and Authorization.
+//Answers other than 2xx are errors
+func (s *RTSPSession) Do(ctx context.Context, method, uri string, header map[string]string) (*RTSPResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	deadline := time.Now().Add(s.timeout())
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	s.conn.SetDeadline(deadline)
+	defer s.conn.SetDeadline(time.Time{})
+
+	response, err := s.do(method, uri, header)
+	if err == nil && response.StatusCode == 401 && s.username != "" {
+		//answer the challenge, a digest one when offered
+		challenges := response.Header[textproto.CanonicalMIMEHeaderKey("WWW-Authenticate")]
+		retry := s.digest.Update(challenges)
+		if !retry && !s.basic {
+			for _, challenge := range challenges {
+				if strings.HasPrefix(strings.ToLower(strings.TrimSpace(challenge)), "basic") {
+					s.basic, retry = true, true
+				}
+			}
+		}
+		if retry {
+			response, err = s.do(method, uri, header)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return response, fmt.Errorf("rtsp %s %s answered %d %s", method, uri, response.StatusCode, response.Status)
+	}
+	if session := response.Header.Get("Session"); session != "" {
+		s.session = strings.TrimSpace(strings.Split(session, ";")[0])
+	}
+	return response, nil
+}
+
+func (s *RTSPSession) do(method, uri string, header map[string]string) (*RTSPResponse, error) {
+	s.cseq++
+	var request strings.Builder
+	fmt.Fprintf(&request, "%s %s RTSP/1.0\r\nCSeq: %d\r\n", method, uri, s.cseq)
+
+	fields := map[string]string{"Require": "onvif-replay"}
+	if s.UserAgent != "" {
+		fields["User-Agent"] = s.UserAgent
+	}
+	if s.session != "" {
+		fields["Session"] = s.session
+	}
+	if authorization := s.authorization(method, uri); authorization != "" {
+		fields["Authorization"] = authorization
+	}
+	for key, value := range header {
+		fields[key] = value
+	}
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Fprintf(&request, "%s: %s\r\n", key, fields[key])
+	}
+	request.WriteString("\r\n")
+
+	if _, err := io.WriteString(s.conn, request.String()); err != nil {
+		return nil, err
+	}
+	for {
+		response, err := s.readResponse()
+		if err != nil {
+			return nil, err
+		}
+		if cseq, _ := strconv.Atoi(response.Header.Get("CSeq")); cseq == s.cseq {
+			return response, nil
+		}
+	}
+}
+
+//readResponse reads the next RTSP message, skipping interleaved packets
+func (s *RTSPSession) readResponse() (*RTSPResponse, error) {
+	for {
+		first, err := s.reader.Peek(1)
+		if err != nil {
+			return nil, err
+		}
+		if first[0] != '$' {
+			break
+		}
+		if _, _, err = s.readInterleaved(); err != nil {
+			return nil, err
+		}
+	}
+
+	reader := textproto.NewReader(s.reader)
+	line, err := reader.ReadLine()
+	if err != nil {
+		return nil, err
+	}
+	parts := strings.SplitN(line, " ", 3)
+	if len(parts) < 2 || !strings.HasPrefix(parts[0], "RTSP/") {
+		return nil, errors.New("malformed rtsp status line: " + line)
+	}
+	response := &RTSPResponse{}
+	if response.StatusCode, err = strconv.Atoi(parts[1]); err != nil {
+		return nil, errors.New("malformed rtsp status line: " + line)
+	}
+	if len(parts) == 3 {
+		response.Status = parts[2]
+	}
+	if response.Header, err = reader.ReadMIMEHeader(); err != nil {
+		return nil, err
+	}
+	if length, _ := strconv.Atoi(response.Header.Get("Content-Length")); length > 0 {
+		response.Body = make([]byte, length)
+		if _, err = io.ReadFull(s.reader, response.Body); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+func (s *RTSPSession) readInterleaved() (byte, []byte, error) {
+	var frame [4]byte
+	if _, err := io.ReadFull(s.reader, frame[:]); err != nil {
+		return 0, nil, err
+	}
+	payload := make([]byte, int(frame[2])<<8|int(frame[3]))
+	if _, err := io.ReadFull(s.reader, payload); err != nil {
+		return 0, nil, err
+	}
+	return frame[1], payload, nil
+}
+
+//authorization returns the Authorization header answering the challenge of the server
+func (s *RTSPSession) authorization(method, uri string) string {
+	if authorization := s.digest.Authorization(method, uri, ""); authorization != "" {
+		return authorization
+	}
+	if s.basic {
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(s.username+":"+s.password))
+	}
+	return ""
+}
+
+func (s *RTSPSession) timeout() time.Duration {
+	if s.Timeout <= 0 {
+		return 10 * time.Second
+	}
+	return s.Timeout
+}
+
+//sdpControls returns the aggregate control uri and the control uris of the media of <sdp>,
+//relative controls are resolved against <base>
+func sdpControls(sdp []byte, base string) (string, []string) {
+	resolve := func(control string) string {
+		if strings.HasPrefix(control, "rtsp://") {
+			return control
+		}
+		if control == "*" || control == "" {
+			return base
+		}
+		return strings.TrimSuffix(base, "/") + "/" + control
+	}
+
+	aggregate := base
+	var tracks []string
+	media := false
+	for _, line := range strings.Split(string(sdp), "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, "m="):
+			media = true
+			tracks = append(tracks, base)
+		case strings.HasPrefix(line, "a=control:"):
+			control := resolve(strings.TrimSpace(strings.TrimPrefix(line, "a=control:")))
+			if media {
+				tracks[len(tracks)-1] = control
+			} else {
+				aggregate = control
+			}
+		}
+	}
+	return aggregate, tracks
+}
@@ -0,0 +1,120 @@
+package replay
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testSDP = "v=0\r\no=- 0 0 IN IP4 127.0.0.1\r\ns=replay\r\nt=0 0\r\na=control:*\r\n" +
+	"m=video 0 RTP/AVP 96\r\na=rtpmap:96 H264/90000\r\na=control:trackID=1\r\n" +
+	"m=application 0 RTP/AVP 107\r\na=rtpmap:107 vnd.onvif.metadata/90000\r\na=control:trackID=2\r\n"
+
+//fakeRTSPServer answers one connection like a replay server asking for digest authentication,
+//the received requests are sent on <requests>
+func fakeRTSPServer(t *testing.T, requests chan<- textproto.MIMEHeader) net.Listener {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		reader := textproto.NewReader(bufio.NewReader(conn))
+		for {
+			line, err := reader.ReadLine()
+			if err != nil {
+				return
+			}
+			header, err := reader.ReadMIMEHeader()
+			if err != nil {
+				return
+			}
+			header.Set("X-Request", line)
+			requests <- header
+
+			cseq := header.Get("Cseq")
+			switch {
+			case header.Get("Authorization") == "":
+				fmt.Fprintf(conn, "RTSP/1.0 401 Unauthorized\r\nCSeq: %s\r\nWWW-Authenticate: Basic realm=\"replay\"\r\n"+
+					"WWW-Authenticate: Digest realm=\"replay\", nonce=\"b7c1\", qop=\"auth,auth-int\"\r\n\r\n", cseq)
+			case strings.HasPrefix(line, "DESCRIBE"):
+				fmt.Fprintf(conn, "RTSP/1.0 200 OK\r\nCSeq: %s\r\nContent-Base: rtsp://%s/recording/\r\nContent-Type: application/sdp\r\n"+
+					"Content-Length: %d\r\n\r\n%s", cseq, listener.Addr(), len(testSDP), testSDP)
+			case strings.HasPrefix(line, "PLAY"):
+				fmt.Fprintf(conn, "RTSP/1.0 200 OK\r\nCSeq: %s\r\nSession: 4711\r\n\r\n", cseq)
+				conn.Write([]byte{'$', 0, 0, 3, 0x80, 0x60, 0x01})
+			default:
+				fmt.Fprintf(conn, "RTSP/1.0 200 OK\r\nCSeq: %s\r\nSession: 4711;timeout=60\r\n\r\n", cseq)
+			}
+		}
+	}()
+	return listener
+}
+
+func TestRTSPSession(t *testing.T) {
+
+	requests := make(chan textproto.MIMEHeader, 16)
+	listener := fakeRTSPServer(t, requests)
+	defer listener.Close()
+
+	ctx := context.Background()
+	session, err := DialRTSP(ctx, "rtsp://admin:secret@"+listener.Addr().String()+"/recording", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer session.Close()
+
+	if _, err = session.Describe(ctx); err != nil {
+		t.Fatal(err)
+	}
+	<-requests
+	describe := <-requests
+	authorization := describe.Get("Authorization")
+	if !strings.HasPrefix(authorization, `Digest username="admin", realm="replay", nonce="b7c1"`) ||
+		!strings.Contains(authorization, "qop=auth, nc=00000001, cnonce=") || describe.Get("Require") != "onvif-replay" {
+		t.Errorf("unexpected DESCRIBE %v", describe)
+	}
+	base := "rtsp://" + listener.Addr().String() + "/recording/"
+	if tracks := session.Tracks(); len(tracks) != 2 || tracks[0] != base+"trackID=1" || tracks[1] != base+"trackID=2" {
+		t.Fatalf("unexpected tracks %v", tracks)
+	}
+
+	if err = session.Setup(ctx); err != nil {
+		t.Fatal(err)
+	}
+	<-requests
+	if setup := <-requests; setup.Get("Transport") != "RTP/AVP/TCP;unicast;interleaved=2-3" || setup.Get("Session") != "4711" {
+		t.Errorf("unexpected SETUP %v", setup)
+	}
+
+	from := time.Date(2009, 6, 15, 11, 49, 0, 440e6, time.UTC)
+	if _, err = session.Play(ctx, Playback{From: from, To: from.Add(-time.Minute), Scale: -1.5, NoRateControl: true, Immediate: true}); err != nil {
+		t.Fatal(err)
+	}
+	play := <-requests
+	if play.Get("X-Request") != "PLAY "+base+" RTSP/1.0" || play.Get("Range") != "clock=20090615T114900.440Z-20090615T114800.440Z" ||
+		play.Get("Scale") != "-1.5" || play.Get("Rate-Control") != "no" || play.Get("Immediate") != "yes" {
+		t.Errorf("unexpected PLAY %v", play)
+	}
+
+	channel, packet, err := session.ReadPacket()
+	if err != nil || channel != 0 || len(packet) != 3 || packet[0] != 0x80 {
+		t.Errorf("unexpected packet %d %x %v", channel, packet, err)
+	}
+
+	if err = session.Teardown(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if teardown := <-requests; !strings.HasPrefix(teardown.Get("X-Request"), "TEARDOWN") {
+		t.Errorf("unexpected request %v", teardown)
+	}
+}
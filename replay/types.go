@@ -21,9 +21,26 @@ type GetServiceCapabilities struct {
 
 type GetServiceCapabilitiesResponse struct {
 	Capabilities struct {
-		ReversePlayback     xsd.Boolean `xml:"ReversePlayback,attr"`
-		SessionTimeoutRange xsd.Int     `xml:"SessionTimeoutRange,attr"`
-		RTP_RTSP_TCP        xsd.Boolean `xml:"RTP_RTSP_TCP,attr"`
-		RTSPWebSocketUri    xsd.AnyURI  `xml:"RTSPWebSocketUri,attr"`
+		ReversePlayback     xsd.Boolean         `xml:"ReversePlayback,attr"`
+		SessionTimeoutRange onvif.FloatAttrList `xml:"SessionTimeoutRange,attr"`
+		RTP_RTSP_TCP        xsd.Boolean         `xml:"RTP_RTSP_TCP,attr"`
+		RTSPWebSocketUri    xsd.AnyURI          `xml:"RTSPWebSocketUri,attr"`
 	} `xml:"Capabilities"`
 }
+
+type GetReplayConfiguration struct {
+	XMLName string `xml:"trp:GetReplayConfiguration"`
+}
+
+type GetReplayConfigurationResponse struct {
+	Configuration struct {
+		SessionTimeout xsd.Duration
+	}
+}
+
+type SetReplayConfiguration struct {
+	XMLName       string                    `xml:"trp:SetReplayConfiguration"`
+	Configuration onvif.ReplayConfiguration `xml:"trp:Configuration"`
+}
+
+type SetReplayConfigurationResponse struct{}
@@ -0,0 +1,18 @@
+package search
+
+//go:generate go run ../cmd/clientgen -types types.go -package search -client Client -out search-client.go
+
+import (
+	"github.com/use-go/goonvif"
+)
+
+//Client typed client of the ONVIF Search service,
+//its methods are generated from types.go by cmd/clientgen
+type Client struct {
+	dev *goonvif.Device
+}
+
+//NewClient returns a Search service client of dev
+func NewClient(dev *goonvif.Device) *Client {
+	return &Client{dev: dev}
+}
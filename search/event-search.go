@@ -0,0 +1,114 @@
+package search
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/use-go/goonvif"
+	"github.com/use-go/goonvif/event"
+	"github.com/use-go/goonvif/xsd"
+)
+
+//EventResult an event found in the recordings
+type EventResult struct {
+	RecordingToken  string
+	TrackToken      string
+	Time            time.Time
+	Event           event.NotificationMessage
+	//Payload the decoded Event, a pointer to the type registered for its topic,
+	//e.g. *event.MotionAlarm, or a *event.Notification, nil when the result has no event
+	Payload         interface{}
+	StartStateEvent bool
+}
+
+//EventSearch iterates the results of a FindEvents search:
+//
+//	events, err := search.SearchEvents(ctx, dev, request)
+//	defer events.Close()
+//	for events.Next(ctx) {
+//		result := events.Result()
+//	}
+//	err = events.Err()
+type EventSearch struct {
+	Session
+	results []FindEventResult
+	result  EventResult
+}
+
+//SearchEvents starts the FindEvents <request> on <dev>, KeepAliveTime defaults to 10s
+func SearchEvents(ctx context.Context, dev *goonvif.Device, request FindEvents) (*EventSearch, error) {
+	if request.KeepAliveTime == "" {
+		request.KeepAliveTime = defaultKeepAliveTime
+	}
+	session, err := startSession(ctx, dev, request)
+	if err != nil {
+		return nil, err
+	}
+	search := &EventSearch{Session: session}
+	search.poll = search.pollResults
+	search.buffered = func() int { return len(search.results) }
+	return search, nil
+}
+
+//Next advances to the next result, polling the device until the search is completed.
+//It returns false at the end of the results, after an error and when ctx is done,
+//the search is then ended on the device
+func (search *EventSearch) Next(ctx context.Context) bool {
+	if !search.next(ctx) {
+		search.results = nil
+		return false
+	}
+	result := search.results[0]
+	search.results = search.results[1:]
+	var err error
+	if search.result, err = newEventResult(result); err != nil {
+		search.results = nil
+		return search.fail(err)
+	}
+	return true
+}
+
+//Result returns the current result of Next
+func (search *EventSearch) Result() EventResult {
+	return search.result
+}
+
+func (search *EventSearch) pollResults(ctx context.Context, request resultsRequest) (string, error) {
+	var response GetEventSearchResultsResponse
+	err := search.dev.CallMethodUnmarshal(ctx, GetEventSearchResults{
+		SearchToken: request.SearchToken,
+		MaxResults:  request.MaxResults,
+		WaitTime:    request.WaitTime,
+	}, &response)
+	if err != nil {
+		return "", err
+	}
+	search.results = response.ResultList.Result
+	return response.ResultList.SearchState, nil
+}
+
+func newEventResult(result FindEventResult) (EventResult, error) {
+	eventTime, err := xsd.ParseDateTime(string(result.Time))
+	if err != nil {
+		return EventResult{}, err
+	}
+	//an event without time happened at the time of the result, a nil event has no payload
+	var payload interface{}
+	if message := result.Event; strings.TrimSpace(string(message.Topic.Value)) != "" {
+		if strings.TrimSpace(string(message.Message.UtcTime)) == "" {
+			message.Message.UtcTime = result.Time
+		}
+		if payload, err = message.Decode(); err != nil {
+			return EventResult{}, err
+		}
+	}
+	return EventResult{
+		RecordingToken:  strings.TrimSpace(result.RecordingToken),
+		TrackToken:      strings.TrimSpace(result.TrackToken),
+		Time:            eventTime,
+		Event:           result.Event,
+		Payload:         payload,
+		StartStateEvent: bool(result.StartStateEvent),
+	}, nil
+}
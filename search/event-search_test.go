@@ -0,0 +1,232 @@
+package search
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/use-go/goonvif"
+	"github.com/use-go/goonvif/event"
+)
+
+const completedResults = `<SOAP-ENV:Envelope xmlns:SOAP-ENV="http://www.w3.org/2003/05/soap-envelope" xmlns:tt="http://www.onvif.org/ver10/schema"
+	xmlns:tse="http://www.onvif.org/ver10/search/wsdl" xmlns:wsnt="http://docs.oasis-open.org/wsn/b-2"><SOAP-ENV:Body>
+	<tse:GetEventSearchResultsResponse><tse:ResultList><tt:SearchState>Completed</tt:SearchState><tt:Result>
+	<tt:RecordingToken>recordstream</tt:RecordingToken><tt:TrackToken>video</tt:TrackToken><tt:Time>2018-06-13T02:00:00Z</tt:Time>
+	<tt:Event><wsnt:Topic Dialect="http://www.onvif.org/ver10/tev/topicExpression/ConcreteSet">tns1:VideoSource/MotionAlarm</wsnt:Topic>
+	<wsnt:Message><tt:Message UtcTime="2018-06-13T02:00:00Z" PropertyOperation="Changed">
+	<tt:Source><tt:SimpleItem Name="Source" Value="VideoSourceToken"/></tt:Source>
+	<tt:Data><tt:SimpleItem Name="State" Value="true"/></tt:Data></tt:Message></wsnt:Message></tt:Event>
+	<tt:StartStateEvent>true</tt:StartStateEvent></tt:Result></tse:ResultList></tse:GetEventSearchResultsResponse>
+	</SOAP-ENV:Body></SOAP-ENV:Envelope>`
+
+//mockedSearchDevice answers the results of test-data, then the completed results
+func mockedSearchDevice(t *testing.T, ends *int32) *httptest.Server {
+	queued, err := ioutil.ReadFile("test-data/GetEventSearchResultsResponse.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var polls int32
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		request := string(data)
+		envelope := `<SOAP-ENV:Envelope xmlns:SOAP-ENV="http://www.w3.org/2003/05/soap-envelope" xmlns:tds="http://www.onvif.org/ver10/device/wsdl"
+			xmlns:tse="http://www.onvif.org/ver10/search/wsdl"><SOAP-ENV:Body>%s</SOAP-ENV:Body></SOAP-ENV:Envelope>`
+		switch {
+		case strings.Contains(request, "GetServices"):
+			w.Write([]byte(strings.Replace(envelope, "%s", `<tds:GetServicesResponse><tds:Service>
+				<tds:Namespace>http://www.onvif.org/ver10/search/wsdl</tds:Namespace><tds:XAddr>`+server.URL+`/onvif/search</tds:XAddr>
+				</tds:Service></tds:GetServicesResponse>`, 1)))
+		case strings.Contains(request, "FindEvents"):
+			w.Write([]byte(strings.Replace(envelope, "%s", `<tse:FindEventsResponse><tse:SearchToken>search-1</tse:SearchToken></tse:FindEventsResponse>`, 1)))
+		case strings.Contains(request, "FindRecordings"):
+			w.Write([]byte(strings.Replace(envelope, "%s", `<tse:FindRecordingsResponse><tse:SearchToken>search-2</tse:SearchToken></tse:FindRecordingsResponse>`, 1)))
+		case strings.Contains(request, "GetRecordingSearchResults"):
+			w.Write([]byte(strings.Replace(envelope, "%s", `<tse:GetRecordingSearchResultsResponse xmlns:tt="http://www.onvif.org/ver10/schema"><tse:ResultList>
+				<tt:SearchState>Completed</tt:SearchState><tt:RecordingInformation><tt:RecordingToken>recordstream</tt:RecordingToken>
+				<tt:Source><tt:SourceId>video-1</tt:SourceId><tt:Name>Gate</tt:Name></tt:Source>
+				<tt:Track><tt:TrackToken>video</tt:TrackToken><tt:TrackType>Video</tt:TrackType></tt:Track>
+				<tt:RecordingStatus>Recording</tt:RecordingStatus></tt:RecordingInformation></tse:ResultList></tse:GetRecordingSearchResultsResponse>`, 1)))
+		case strings.Contains(request, "FindPTZPosition"):
+			w.Write([]byte(strings.Replace(envelope, "%s", `<tse:FindPTZPositionResponse><tse:SearchToken>search-3</tse:SearchToken></tse:FindPTZPositionResponse>`, 1)))
+		case strings.Contains(request, "GetPTZPositionSearchResults"):
+			w.Write([]byte(strings.Replace(envelope, "%s", `<tse:GetPTZPositionSearchResultsResponse xmlns:tt="http://www.onvif.org/ver10/schema"><tse:ResultList>
+				<tt:SearchState>Completed</tt:SearchState><tt:Result><tt:RecordingToken>recordstream</tt:RecordingToken><tt:TrackToken>ptz</tt:TrackToken>
+				<tt:Time>2018-06-13T02:00:00Z</tt:Time><tt:Position><tt:PanTilt x="0.5" y="-0.25"/><tt:Zoom x="0.1"/></tt:Position></tt:Result>
+				</tse:ResultList></tse:GetPTZPositionSearchResultsResponse>`, 1)))
+		case strings.Contains(request, "FindMetadata"):
+			w.Write([]byte(strings.Replace(envelope, "%s", `<tse:FindMetadataResponse><tse:SearchToken>search-4</tse:SearchToken></tse:FindMetadataResponse>`, 1)))
+		case strings.Contains(request, "GetMetadataSearchResults"):
+			w.Write([]byte(strings.Replace(envelope, "%s", `<tse:GetMetadataSearchResultsResponse xmlns:tt="http://www.onvif.org/ver10/schema"><tse:ResultList>
+				<tt:SearchState>Completed</tt:SearchState><tt:Result><tt:RecordingToken>recordstream</tt:RecordingToken>
+				<tt:TrackToken>metadata</tt:TrackToken><tt:Time>2018-06-13T02:00:00Z</tt:Time></tt:Result>
+				<tt:Result><tt:RecordingToken>recordstream</tt:RecordingToken><tt:TrackToken>metadata</tt:TrackToken>
+				<tt:Time>2018-06-13T02:05:00Z</tt:Time></tt:Result></tse:ResultList></tse:GetMetadataSearchResultsResponse>`, 1)))
+		case strings.Contains(request, "GetEventSearchResults"):
+			if atomic.AddInt32(&polls, 1)%2 == 1 {
+				w.Write(queued)
+			} else {
+				w.Write([]byte(completedResults))
+			}
+		case strings.Contains(request, "EndSearch"):
+			atomic.AddInt32(ends, 1)
+			w.Write([]byte(strings.Replace(envelope, "%s", `<tse:EndSearchResponse><tse:Endpoint>2018-06-13T02:00:00Z</tse:Endpoint></tse:EndSearchResponse>`, 1)))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	return server
+}
+
+func TestEventSearch(t *testing.T) {
+
+	var ends int32
+	server := mockedSearchDevice(t, &ends)
+	defer server.Close()
+
+	dev, err := goonvif.NewDevice(strings.TrimPrefix(server.URL, "http://"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	events, err := SearchEvents(ctx, dev, FindEvents{StartPoint: "2018-06-12T00:00:00Z"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var results []EventResult
+	for events.Next(ctx) {
+		results = append(results, events.Result())
+	}
+	if events.Err() != nil || len(results) != 13 {
+		t.Fatalf("%d results, %v", len(results), events.Err())
+	}
+	if !results[0].Time.Equal(time.Date(2018, 6, 12, 11, 2, 24, 0, time.UTC)) || results[0].Payload != nil {
+		t.Errorf("unexpected result %+v", results[0])
+	}
+	last := results[12]
+	if !last.StartStateEvent || last.Event.Topic.Value != "tns1:VideoSource/MotionAlarm" || len(last.Event.Message.Data.SimpleItem) != 1 {
+		t.Errorf("unexpected result %+v", last)
+	}
+	//the event is decoded by the type of its topic
+	if alarm, ok := last.Payload.(*event.MotionAlarm); !ok || !alarm.State || alarm.Source != "VideoSourceToken" ||
+		!alarm.UtcTime.Equal(time.Date(2018, 6, 13, 2, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected payload %+v", last.Payload)
+	}
+	if atomic.LoadInt32(&ends) != 0 {
+		t.Error("completed search ended")
+	}
+
+	//a cancelled search is ended
+	cancelCtx, cancel := context.WithCancel(ctx)
+	if events, err = SearchEvents(cancelCtx, dev, FindEvents{StartPoint: "2018-06-12T00:00:00Z"}); err != nil {
+		t.Fatal(err)
+	}
+	events.Next(cancelCtx)
+	cancel()
+	if events.Next(cancelCtx) || events.Err() == nil || atomic.LoadInt32(&ends) != 1 {
+		t.Errorf("cancelled search not ended, %v", events.Err())
+	}
+}
+
+func TestRecordingSearch(t *testing.T) {
+
+	var ends int32
+	server := mockedSearchDevice(t, &ends)
+	defer server.Close()
+
+	dev, err := goonvif.NewDevice(strings.TrimPrefix(server.URL, "http://"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	recordings, err := SearchRecordings(ctx, dev, FindRecordings{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var results []RecordingInformation
+	for recordings.Next(ctx) {
+		results = append(results, recordings.Result())
+	}
+	if recordings.Err() != nil || len(results) != 1 || recordings.Token() != "search-2" {
+		t.Fatalf("%d results, %v", len(results), recordings.Err())
+	}
+	if results[0].Source.Name != "Gate" || len(results[0].Track) != 1 || results[0].Track[0].TrackType != "Video" {
+		t.Errorf("unexpected recording %+v", results[0])
+	}
+	if atomic.LoadInt32(&ends) != 0 {
+		t.Error("completed search ended")
+	}
+}
+
+func TestPTZPositionSearch(t *testing.T) {
+
+	var ends int32
+	server := mockedSearchDevice(t, &ends)
+	defer server.Close()
+
+	dev, err := goonvif.NewDevice(strings.TrimPrefix(server.URL, "http://"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	positions, err := SearchPTZPositions(ctx, dev, FindPTZPosition{StartPoint: "2018-06-12T00:00:00Z"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var results []PTZPositionResult
+	for positions.Next(ctx) {
+		results = append(results, positions.Result())
+	}
+	if positions.Err() != nil || len(results) != 1 || positions.Token() != "search-3" {
+		t.Fatalf("%d results, %v", len(results), positions.Err())
+	}
+	position := results[0].Position
+	if results[0].TrackToken != "ptz" || !results[0].Time.Equal(time.Date(2018, 6, 13, 2, 0, 0, 0, time.UTC)) ||
+		position.PanTilt == nil || position.PanTilt.X != 0.5 || position.PanTilt.Y != -0.25 || position.Zoom == nil || position.Zoom.X != 0.1 {
+		t.Errorf("unexpected position %+v", results[0])
+	}
+	if atomic.LoadInt32(&ends) != 0 {
+		t.Error("completed search ended")
+	}
+}
+
+func TestMetadataSearch(t *testing.T) {
+
+	var ends int32
+	server := mockedSearchDevice(t, &ends)
+	defer server.Close()
+
+	dev, err := goonvif.NewDevice(strings.TrimPrefix(server.URL, "http://"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	matches, err := SearchMetadata(ctx, dev, FindMetadata{StartPoint: "2018-06-12T00:00:00Z"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var results []MetadataResult
+	for matches.Next(ctx) {
+		results = append(results, matches.Result())
+	}
+	if matches.Err() != nil || len(results) != 2 || matches.Token() != "search-4" {
+		t.Fatalf("%d results, %v", len(results), matches.Err())
+	}
+	if results[1].TrackToken != "metadata" || !results[1].Time.Equal(time.Date(2018, 6, 13, 2, 5, 0, 0, time.UTC)) {
+		t.Errorf("unexpected match %+v", results[1])
+	}
+	if atomic.LoadInt32(&ends) != 0 {
+		t.Error("completed search ended")
+	}
+}
@@ -0,0 +1,79 @@
+package search
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/use-go/goonvif"
+	"github.com/use-go/goonvif/xsd"
+)
+
+//MetadataResult a metadata match found in the recordings
+type MetadataResult struct {
+	RecordingToken string
+	TrackToken     string
+	Time           time.Time
+}
+
+//MetadataSearch iterates the results of a FindMetadata search like EventSearch
+type MetadataSearch struct {
+	Session
+	results []FindMetadataResult
+	result  MetadataResult
+}
+
+//SearchMetadata starts the FindMetadata <request> on <dev>, KeepAliveTime defaults to 10s
+func SearchMetadata(ctx context.Context, dev *goonvif.Device, request FindMetadata) (*MetadataSearch, error) {
+	if request.KeepAliveTime == "" {
+		request.KeepAliveTime = defaultKeepAliveTime
+	}
+	session, err := startSession(ctx, dev, request)
+	if err != nil {
+		return nil, err
+	}
+	search := &MetadataSearch{Session: session}
+	search.poll = search.pollResults
+	search.buffered = func() int { return len(search.results) }
+	return search, nil
+}
+
+//Next advances to the next match, see EventSearch.Next
+func (search *MetadataSearch) Next(ctx context.Context) bool {
+	if !search.next(ctx) {
+		search.results = nil
+		return false
+	}
+	result := search.results[0]
+	search.results = search.results[1:]
+	matchTime, err := xsd.ParseDateTime(string(result.Time))
+	if err != nil {
+		search.results = nil
+		return search.fail(err)
+	}
+	search.result = MetadataResult{
+		RecordingToken: strings.TrimSpace(result.RecordingToken),
+		TrackToken:     strings.TrimSpace(result.TrackToken),
+		Time:           matchTime,
+	}
+	return true
+}
+
+//Result returns the current match of Next
+func (search *MetadataSearch) Result() MetadataResult {
+	return search.result
+}
+
+func (search *MetadataSearch) pollResults(ctx context.Context, request resultsRequest) (string, error) {
+	var response GetMetadataSearchResultsResponse
+	err := search.dev.CallMethodUnmarshal(ctx, GetMetadataSearchResults{
+		SearchToken: request.SearchToken,
+		MaxResults:  request.MaxResults,
+		WaitTime:    request.WaitTime,
+	}, &response)
+	if err != nil {
+		return "", err
+	}
+	search.results = response.ResultList.Result
+	return response.ResultList.SearchState, nil
+}
@@ -0,0 +1,81 @@
+package search
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/use-go/goonvif"
+	"github.com/use-go/goonvif/xsd"
+)
+
+//PTZPositionResult a PTZ position found in the recordings
+type PTZPositionResult struct {
+	RecordingToken string
+	TrackToken     string
+	Time           time.Time
+	Position       PTZPosition
+}
+
+//PTZPositionSearch iterates the results of a FindPTZPosition search like EventSearch
+type PTZPositionSearch struct {
+	Session
+	results []FindPTZPositionResult
+	result  PTZPositionResult
+}
+
+//SearchPTZPositions starts the FindPTZPosition <request> on <dev>, KeepAliveTime defaults to 10s
+func SearchPTZPositions(ctx context.Context, dev *goonvif.Device, request FindPTZPosition) (*PTZPositionSearch, error) {
+	if request.KeepAliveTime == "" {
+		request.KeepAliveTime = defaultKeepAliveTime
+	}
+	session, err := startSession(ctx, dev, request)
+	if err != nil {
+		return nil, err
+	}
+	search := &PTZPositionSearch{Session: session}
+	search.poll = search.pollResults
+	search.buffered = func() int { return len(search.results) }
+	return search, nil
+}
+
+//Next advances to the next position, see EventSearch.Next
+func (search *PTZPositionSearch) Next(ctx context.Context) bool {
+	if !search.next(ctx) {
+		search.results = nil
+		return false
+	}
+	result := search.results[0]
+	search.results = search.results[1:]
+	positionTime, err := xsd.ParseDateTime(string(result.Time))
+	if err != nil {
+		search.results = nil
+		return search.fail(err)
+	}
+	search.result = PTZPositionResult{
+		RecordingToken: strings.TrimSpace(result.RecordingToken),
+		TrackToken:     strings.TrimSpace(result.TrackToken),
+		Time:           positionTime,
+		Position:       result.Position,
+	}
+	return true
+}
+
+//Result returns the current position of Next
+func (search *PTZPositionSearch) Result() PTZPositionResult {
+	return search.result
+}
+
+func (search *PTZPositionSearch) pollResults(ctx context.Context, request resultsRequest) (string, error) {
+	var response GetPTZPositionSearchResultsResponse
+	err := search.dev.CallMethodUnmarshal(ctx, GetPTZPositionSearchResults{
+		SearchToken: request.SearchToken,
+		MaxResults:  request.MaxResults,
+		WaitTime:    request.WaitTime,
+	}, &response)
+	if err != nil {
+		return "", err
+	}
+	search.results = response.ResultList.Result
+	return response.ResultList.SearchState, nil
+}
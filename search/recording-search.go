@@ -0,0 +1,59 @@
+package search
+
+import (
+	"context"
+
+	"github.com/use-go/goonvif"
+)
+
+//RecordingSearch iterates the results of a FindRecordings search like EventSearch
+type RecordingSearch struct {
+	Session
+	results []RecordingInformation
+	result  RecordingInformation
+}
+
+//SearchRecordings starts the FindRecordings <request> on <dev>, KeepAliveTime defaults to 10s
+func SearchRecordings(ctx context.Context, dev *goonvif.Device, request FindRecordings) (*RecordingSearch, error) {
+	if request.KeepAliveTime == "" {
+		request.KeepAliveTime = defaultKeepAliveTime
+	}
+	session, err := startSession(ctx, dev, request)
+	if err != nil {
+		return nil, err
+	}
+	search := &RecordingSearch{Session: session}
+	search.poll = search.pollResults
+	search.buffered = func() int { return len(search.results) }
+	return search, nil
+}
+
+//Next advances to the next recording, see EventSearch.Next
+func (search *RecordingSearch) Next(ctx context.Context) bool {
+	if !search.next(ctx) {
+		search.results = nil
+		return false
+	}
+	search.result = search.results[0]
+	search.results = search.results[1:]
+	return true
+}
+
+//Result returns the current recording of Next
+func (search *RecordingSearch) Result() RecordingInformation {
+	return search.result
+}
+
+func (search *RecordingSearch) pollResults(ctx context.Context, request resultsRequest) (string, error) {
+	var response GetRecordingSearchResultsResponse
+	err := search.dev.CallMethodUnmarshal(ctx, GetRecordingSearchResults{
+		SearchToken: request.SearchToken,
+		MaxResults:  request.MaxResults,
+		WaitTime:    request.WaitTime,
+	}, &response)
+	if err != nil {
+		return "", err
+	}
+	search.results = response.ResultList.RecordingInformation
+	return response.ResultList.SearchState, nil
+}
@@ -0,0 +1,163 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/use-go/goonvif"
+	"github.com/use-go/goonvif/xsd"
+)
+
+//SearchState values of the search results
+const (
+	SearchStateQueued    = "Queued"
+	SearchStateSearching = "Searching"
+	SearchStateCompleted = "Completed"
+	SearchStateUnknown   = "Unknown"
+)
+
+//defaultKeepAliveTime of the Find operations without KeepAliveTime
+const defaultKeepAliveTime = "PT10S"
+
+//Session a search started on the device by one of the Find operations.
+//The results are polled by the Get<Kind>SearchResults of the search until it is completed,
+//a session left before is ended by EndSearch
+type Session struct {
+	//WaitTime the device may wait for results by one poll, 0 means 1s
+	WaitTime time.Duration
+	//PollInterval between polls that returned nothing, 0 means 500ms
+	PollInterval time.Duration
+	//MaxResults of one poll, 0 means 100
+	MaxResults int
+
+	dev       *goonvif.Device
+	token     string
+	completed bool
+	err       error
+	//poll gets the next results of the search into the buffer of its kind and returns the search state
+	poll func(ctx context.Context, request resultsRequest) (string, error)
+	//buffered returns the number of polled results not taken yet
+	buffered func() int
+}
+
+//resultsRequest common arguments of the Get<Kind>SearchResults operations
+type resultsRequest struct {
+	SearchToken string
+	MaxResults  xsd.Int
+	WaitTime    xsd.Duration
+}
+
+//startSession calls the Find <request> and returns the session of its search token
+func startSession(ctx context.Context, dev *goonvif.Device, request interface{}) (Session, error) {
+	var response struct {
+		SearchToken string
+	}
+	if err := dev.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return Session{}, err
+	}
+	token := strings.TrimSpace(response.SearchToken)
+	if token == "" {
+		return Session{}, errors.New("search returned no search token")
+	}
+	return Session{dev: dev, token: token}, nil
+}
+
+//Token returns the search token of the device
+func (session *Session) Token() string {
+	return session.token
+}
+
+//Err returns the error that stopped the iteration, nil after all results
+func (session *Session) Err() error {
+	return session.err
+}
+
+//State asks the device for the state of the search
+func (session *Session) State(ctx context.Context) (string, error) {
+	var response GetSearchStateResponse
+	if err := session.dev.CallMethodUnmarshal(ctx, GetSearchState{SearchToken: session.token}, &response); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(response.State), nil
+}
+
+//Close ends the search on the device unless it is completed
+func (session *Session) Close() error {
+	if session.completed {
+		return nil
+	}
+	session.completed = true
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var response EndSearchResponse
+	return session.dev.CallMethodUnmarshal(ctx, EndSearch{SearchToken: session.token}, &response)
+}
+
+//next polls the device until a result is buffered. It returns false at the end of the
+//results, after an error and when ctx is done, the search is then ended on the device
+func (session *Session) next(ctx context.Context) bool {
+	for session.err == nil {
+		if session.err = ctx.Err(); session.err != nil {
+			break
+		}
+		if session.buffered() > 0 {
+			return true
+		}
+		if session.completed {
+			return false
+		}
+
+		request := resultsRequest{
+			SearchToken: session.token,
+			MaxResults:  int32Value(session.MaxResults, 100),
+			WaitTime:    xsd.NewDuration(session.waitTime()),
+		}
+		state, err := session.poll(ctx, request)
+		if session.err = err; err != nil {
+			break
+		}
+		session.completed = strings.TrimSpace(state) == SearchStateCompleted
+		if session.buffered() > 0 || session.completed {
+			continue
+		}
+
+		select {
+		case <-time.After(session.pollInterval()):
+		case <-ctx.Done():
+			session.err = ctx.Err()
+		}
+	}
+	session.Close()
+	return false
+}
+
+//fail stops the iteration with <err>
+func (session *Session) fail(err error) bool {
+	session.err = err
+	session.Close()
+	return false
+}
+
+func (session *Session) waitTime() time.Duration {
+	if session.WaitTime <= 0 {
+		return time.Second
+	}
+	return session.WaitTime
+}
+
+func (session *Session) pollInterval() time.Duration {
+	if session.PollInterval <= 0 {
+		return 500 * time.Millisecond
+	}
+	return session.PollInterval
+}
+
+func int32Value(value, defaultValue int) xsd.Int {
+	if value <= 0 {
+		return xsd.Int(defaultValue)
+	}
+	return xsd.Int(value)
+}
@@ -1,20 +1,21 @@
 package search
 
 import (
+	"github.com/use-go/goonvif/event"
 	"github.com/use-go/goonvif/xsd"
 	"github.com/use-go/goonvif/xsd/onvif"
 )
 
-type EventFilter onvif.FilterType
+type EventFilter event.FilterType
 
 type FindEvents struct {
 	XMLName           string            `xml:"tse:FindEvents"`
 	StartPoint        xsd.DateTime      `xml:"tse:StartPoint"`
-	EndPoint          xsd.DateTime      `xml:"tse:EndPoint"`
+	EndPoint          xsd.DateTime      `xml:"tse:EndPoint,omitempty"`
 	Scope             onvif.SearchScope `xml:"tse:Scope"`
-	SearchFilter      EventFilter       `xml:"tse:SearchFilter"`
+	SearchFilter      *EventFilter      `xml:"tse:SearchFilter,omitempty"`
 	IncludeStartState xsd.Boolean       `xml:"tse:IncludeStartState"`
-	MaxMatches        xsd.Int           `xml:"tse:MaxMatches"`
+	MaxMatches        xsd.Int           `xml:"tse:MaxMatches,omitempty"`
 	KeepAliveTime     xsd.Duration      `xml:"tse:KeepAliveTime"`
 }
 
@@ -23,25 +24,262 @@ type FindEventsResponse struct {
 }
 
 type GetEventSearchResults struct {
-	XMLName     string `xml:"tse:GetEventSearchResults"`
-	SearchToken string `xml:"tse:SearchToken"`
+	XMLName     string       `xml:"tse:GetEventSearchResults"`
+	SearchToken string       `xml:"tse:SearchToken"`
+	MinResults  xsd.Int      `xml:"tse:MinResults,omitempty"`
+	MaxResults  xsd.Int      `xml:"tse:MaxResults,omitempty"`
+	WaitTime    xsd.Duration `xml:"tse:WaitTime,omitempty"`
 }
 
 type GetEventSearchResultsResponse struct {
 	ResultList struct {
 		SearchState string
-		Result      []struct {
-			RecordingToken  string
-			TrackToken      string
-			Time            xsd.Duration
-			Event           string
-			StartStateEvent xsd.Boolean
-		}
+		Result      []FindEventResult
 	}
 }
 
+type FindEventResult struct {
+	RecordingToken  string
+	TrackToken      string
+	Time            xsd.DateTime
+	Event           event.NotificationMessage
+	StartStateEvent xsd.Boolean
+}
+
+type EndSearch struct {
+	XMLName     string `xml:"tse:EndSearch"`
+	SearchToken string `xml:"tse:SearchToken"`
+}
+
+type EndSearchResponse struct {
+	Endpoint xsd.DateTime
+}
+
+type GetSearchState struct {
+	XMLName     string `xml:"tse:GetSearchState"`
+	SearchToken string `xml:"tse:SearchToken"`
+}
+
+type GetSearchStateResponse struct {
+	State string
+}
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"tse:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities Capabilities
+}
+
+type Capabilities struct {
+	MetadataSearch     xsd.Boolean `xml:"MetadataSearch,attr"`
+	GeneralStartEvents xsd.Boolean `xml:"GeneralStartEvents,attr"`
+}
+
+type GetRecordingSummary struct {
+	XMLName string `xml:"tse:GetRecordingSummary"`
+}
+
+type GetRecordingSummaryResponse struct {
+	Summary RecordingSummary
+}
+
+type RecordingSummary struct {
+	DataFrom         xsd.DateTime
+	DataUntil        xsd.DateTime
+	NumberRecordings xsd.Int
+}
+
+type GetRecordingInformation struct {
+	XMLName        string                   `xml:"tse:GetRecordingInformation"`
+	RecordingToken onvif.RecordingReference `xml:"tse:RecordingToken"`
+}
+
+type GetRecordingInformationResponse struct {
+	RecordingInformation RecordingInformation
+}
+
+type RecordingInformation struct {
+	RecordingToken    string
+	Source            RecordingSourceInformation
+	EarliestRecording xsd.DateTime
+	LatestRecording   xsd.DateTime
+	Content           string
+	Track             []TrackInformation
+	RecordingStatus   string
+}
+
+type RecordingSourceInformation struct {
+	SourceId    xsd.AnyURI
+	Name        string
+	Location    string
+	Description string
+	Address     xsd.AnyURI
+}
+
+type TrackInformation struct {
+	TrackToken  string
+	TrackType   string
+	Description string
+	DataFrom    xsd.DateTime
+	DataTo      xsd.DateTime
+}
+
+type GetMediaAttributes struct {
+	XMLName         string                     `xml:"tse:GetMediaAttributes"`
+	RecordingTokens []onvif.RecordingReference `xml:"tse:RecordingTokens,omitempty"`
+	Time            xsd.DateTime               `xml:"tse:Time"`
+}
+
+type GetMediaAttributesResponse struct {
+	MediaAttributes []MediaAttributes
+}
+
+type MediaAttributes struct {
+	RecordingToken  string
+	TrackAttributes []TrackAttributes
+	From            xsd.DateTime
+	Until           xsd.DateTime
+}
+
+type TrackAttributes struct {
+	TrackInformation   TrackInformation
+	VideoAttributes    *VideoAttributes
+	AudioAttributes    *AudioAttributes
+	MetadataAttributes *MetadataAttributes
+}
+
+type VideoAttributes struct {
+	Bitrate   xsd.Int
+	Width     xsd.Int
+	Height    xsd.Int
+	Encoding  string
+	Framerate xsd.Float
+}
+
+type AudioAttributes struct {
+	Bitrate    xsd.Int
+	Encoding   string
+	Samplerate xsd.Int
+}
+
+type MetadataAttributes struct {
+	CanContainPTZ           xsd.Boolean
+	CanContainAnalytics     xsd.Boolean
+	CanContainNotifications xsd.Boolean
+	PtzSpaces               onvif.StringAttrList `xml:"PtzSpaces,attr"`
+}
+
 type FindRecordings struct {
-	XMLName string `xml:"tse:FindRecordings"`
+	XMLName       string            `xml:"tse:FindRecordings"`
+	Scope         onvif.SearchScope `xml:"tse:Scope"`
+	MaxMatches    xsd.Int           `xml:"tse:MaxMatches,omitempty"`
+	KeepAliveTime xsd.Duration      `xml:"tse:KeepAliveTime"`
+}
+
+type FindRecordingsResponse struct {
+	SearchToken string
+}
+
+type GetRecordingSearchResults struct {
+	XMLName     string       `xml:"tse:GetRecordingSearchResults"`
+	SearchToken string       `xml:"tse:SearchToken"`
+	MinResults  xsd.Int      `xml:"tse:MinResults,omitempty"`
+	MaxResults  xsd.Int      `xml:"tse:MaxResults,omitempty"`
+	WaitTime    xsd.Duration `xml:"tse:WaitTime,omitempty"`
+}
+
+type GetRecordingSearchResultsResponse struct {
+	ResultList struct {
+		SearchState          string
+		RecordingInformation []RecordingInformation
+	}
 }
 
-type FindRecordingsResponse struct{}
+type PTZPositionFilter struct {
+	MinPosition onvif.PTZVector `xml:"tt:MinPosition"`
+	MaxPosition onvif.PTZVector `xml:"tt:MaxPosition"`
+	EnterOrExit xsd.Boolean     `xml:"tt:EnterOrExit"`
+}
+
+type FindPTZPosition struct {
+	XMLName       string            `xml:"tse:FindPTZPosition"`
+	StartPoint    xsd.DateTime      `xml:"tse:StartPoint"`
+	EndPoint      xsd.DateTime      `xml:"tse:EndPoint,omitempty"`
+	Scope         onvif.SearchScope `xml:"tse:Scope"`
+	SearchFilter  PTZPositionFilter `xml:"tse:SearchFilter"`
+	MaxMatches    xsd.Int           `xml:"tse:MaxMatches,omitempty"`
+	KeepAliveTime xsd.Duration      `xml:"tse:KeepAliveTime"`
+}
+
+type FindPTZPositionResponse struct {
+	SearchToken string
+}
+
+type GetPTZPositionSearchResults struct {
+	XMLName     string       `xml:"tse:GetPTZPositionSearchResults"`
+	SearchToken string       `xml:"tse:SearchToken"`
+	MinResults  xsd.Int      `xml:"tse:MinResults,omitempty"`
+	MaxResults  xsd.Int      `xml:"tse:MaxResults,omitempty"`
+	WaitTime    xsd.Duration `xml:"tse:WaitTime,omitempty"`
+}
+
+type GetPTZPositionSearchResultsResponse struct {
+	ResultList struct {
+		SearchState string
+		Result      []FindPTZPositionResult
+	}
+}
+
+type FindPTZPositionResult struct {
+	RecordingToken string
+	TrackToken     string
+	Time           xsd.DateTime
+	Position       PTZPosition
+}
+
+//PTZPosition of a result, onvif.PTZVector can only be marshaled
+type PTZPosition struct {
+	PanTilt *onvif.Vector2D
+	Zoom    *onvif.Vector1D
+}
+
+type MetadataFilter struct {
+	MetadataStreamFilter string `xml:"tt:MetadataStreamFilter"`
+}
+
+type FindMetadata struct {
+	XMLName        string            `xml:"tse:FindMetadata"`
+	StartPoint     xsd.DateTime      `xml:"tse:StartPoint"`
+	EndPoint       xsd.DateTime      `xml:"tse:EndPoint,omitempty"`
+	Scope          onvif.SearchScope `xml:"tse:Scope"`
+	MetadataFilter MetadataFilter    `xml:"tse:MetadataFilter"`
+	MaxMatches     xsd.Int           `xml:"tse:MaxMatches,omitempty"`
+	KeepAliveTime  xsd.Duration      `xml:"tse:KeepAliveTime"`
+}
+
+type FindMetadataResponse struct {
+	SearchToken string
+}
+
+type GetMetadataSearchResults struct {
+	XMLName     string       `xml:"tse:GetMetadataSearchResults"`
+	SearchToken string       `xml:"tse:SearchToken"`
+	MinResults  xsd.Int      `xml:"tse:MinResults,omitempty"`
+	MaxResults  xsd.Int      `xml:"tse:MaxResults,omitempty"`
+	WaitTime    xsd.Duration `xml:"tse:WaitTime,omitempty"`
+}
+
+type GetMetadataSearchResultsResponse struct {
+	ResultList struct {
+		SearchState string
+		Result      []FindMetadataResult
+	}
+}
+
+type FindMetadataResult struct {
+	RecordingToken string
+	TrackToken     string
+	Time           xsd.DateTime
+}
@@ -1,6 +1,11 @@
 package goonvif
 
-import "net/http"
+import (
+	"net/http"
+	"time"
+
+	"github.com/use-go/goonvif/networking"
+)
 
 //deviceInfo struct contains general information about ONVIF device
 type deviceInfo struct {
@@ -30,11 +35,33 @@ type IOnvifDeviceInfo interface {
 //struct represents an abstract ONVIF device.
 //It contains methods, which helps to communicate with ONVIF device
 type Device struct {
-	ipaddress string
-	port      int
-	xaddr     string
-	login     string
-	password  string
-	endpoints map[string]string
-	info      deviceInfo
+	//clockOffset device clock minus local clock in nanoseconds,
+	//accessed atomically so keep it first for 64-bit alignment
+	clockOffset int64
+	ipaddress   string
+	port        int
+	xaddr       string
+	login       string
+	password    string
+	endpoints   map[string]string
+	services    map[string]ServiceInfo
+	info        deviceInfo
+	authMode    AuthMode
+	digest      *networking.DigestAuth
+	scheme      string
+	transport   networking.Transport
+	timeout     time.Duration
 }
+
+//AuthMode selects how requests to a Device are authenticated
+type AuthMode int
+
+//Authentication modes
+const (
+	//AuthBoth WS-UsernameToken in every request, HTTP Digest when the device challenges (default)
+	AuthBoth AuthMode = iota
+	//AuthWSSecurity WS-UsernameToken only
+	AuthWSSecurity
+	//AuthDigest HTTP Digest only
+	AuthDigest
+)
@@ -8,6 +8,7 @@ import (
 	"log"
 	"net/url"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -205,6 +206,73 @@ func (tp Duration) NewDateTime(years, months, days, hours, minutes, seconds stri
 	return Duration(i.ISO8601Duration())
 }
 
+//NewDuration returns d as xsd duration in seconds, e.g. PT60S or PT0.5S
+func NewDuration(d time.Duration) Duration {
+	sign := ""
+	if d < 0 {
+		sign, d = "-", -d
+	}
+	return Duration(sign + "PT" + strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "S")
+}
+
+//ParseDuration parses an xsd duration of days, hours, minutes and seconds, e.g. PT1M30S.
+//At least one component is required, and one after T when it is given.
+//Years, months and weeks have no fixed length in time.Duration and are an error
+func ParseDuration(value Duration) (time.Duration, error) {
+	invalid := errors.New("invalid xsd duration " + string(value))
+	text := strings.TrimSpace(string(value))
+	negative := strings.HasPrefix(text, "-")
+	text = strings.TrimPrefix(text, "-")
+	if !strings.HasPrefix(text, "P") {
+		return 0, invalid
+	}
+
+	var duration time.Duration
+	inTime := false
+	components := 0
+	for text = text[1:]; text != ""; {
+		if text[0] == 'T' {
+			if inTime {
+				return 0, invalid
+			}
+			inTime, components, text = true, 0, text[1:]
+			continue
+		}
+		end := strings.IndexAny(text, "YMWDHS")
+		if end <= 0 || text[0] < '0' || text[0] > '9' {
+			return 0, invalid
+		}
+		number, err := strconv.ParseFloat(text[:end], 64)
+		if err != nil {
+			return 0, invalid
+		}
+		var unit time.Duration
+		switch {
+		case text[end] == 'D' && !inTime:
+			unit = 24 * time.Hour
+		case text[end] == 'H' && inTime:
+			unit = time.Hour
+		case text[end] == 'M' && inTime:
+			unit = time.Minute
+		case text[end] == 'S' && inTime:
+			unit = time.Second
+		default:
+			return 0, errors.New("xsd duration " + string(value) + " has no fixed length")
+		}
+		duration += time.Duration(number * float64(unit))
+		components++
+		text = text[end+1:]
+	}
+	//components counts the ones after T when it was given
+	if components == 0 {
+		return 0, invalid
+	}
+	if negative {
+		duration = -duration
+	}
+	return duration, nil
+}
+
 /*
 	DateTime values may be viewed as objects with integer-valued year, month, day, hour
 	and minute properties, a decimal-valued second property, and a boolean timezoned property.
@@ -239,6 +307,15 @@ func (tp DateTime) NewDateTime(time time.Time) DateTime {
 	return DateTime(time.Format("2002-10-10T12:00:00-05:00"))
 }
 
+//ParseDateTime parses an xsd dateTime, some devices omit the time zone of UTC
+func ParseDateTime(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if dateTime, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return dateTime, nil
+	}
+	return time.Parse("2006-01-02T15:04:05.999999999", value)
+}
+
 /*
 	Time represents an instant of time that recurs every day.
 	The ·value space· of time is the space of time of day values
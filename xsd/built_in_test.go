@@ -0,0 +1,86 @@
+package xsd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewDuration(t *testing.T) {
+
+	for _, test := range []struct {
+		duration time.Duration
+		expected Duration
+	}{
+		{time.Minute, "PT60S"},
+		{500 * time.Millisecond, "PT0.5S"},
+		{0, "PT0S"},
+		{-90 * time.Second, "-PT90S"},
+	} {
+		if value := NewDuration(test.duration); value != test.expected {
+			t.Errorf("expected %s for %s, got %s", test.expected, test.duration, value)
+		}
+		if duration, err := ParseDuration(NewDuration(test.duration)); err != nil || duration != test.duration {
+			t.Errorf("%s parsed as %s, %v", test.expected, duration, err)
+		}
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+
+	for _, test := range []struct {
+		value    Duration
+		expected time.Duration
+		valid    bool
+	}{
+		{"PT1M30S", 90 * time.Second, true},
+		{" P1DT2H ", 26 * time.Hour, true},
+		{"P2D", 48 * time.Hour, true},
+		{"PT0.25S", 250 * time.Millisecond, true},
+		{"-PT10S", -10 * time.Second, true},
+		{"PT0S", 0, true},
+		//no component, or none after T
+		{"P", 0, false},
+		{"PT", 0, false},
+		{"P1DT", 0, false},
+		{"-P", 0, false},
+		{"PT1HT2M", 0, false},
+		{"PT-1S", 0, false},
+		{"PTS", 0, false},
+		{"T10S", 0, false},
+		{"10", 0, false},
+		{"", 0, false},
+		//no fixed length
+		{"P1Y", 0, false},
+		{"P1M", 0, false},
+		{"P1W", 0, false},
+		{"PT1D", 0, false},
+	} {
+		duration, err := ParseDuration(test.value)
+		if (err == nil) != test.valid || duration != test.expected {
+			t.Errorf("unexpected %s, %v for %q", duration, err, test.value)
+		}
+	}
+}
+
+func TestParseDateTime(t *testing.T) {
+
+	for _, test := range []struct {
+		value    string
+		expected time.Time
+		valid    bool
+	}{
+		{"2018-06-13T02:00:00Z", time.Date(2018, 6, 13, 2, 0, 0, 0, time.UTC), true},
+		{"2018-06-13T02:00:00.25Z", time.Date(2018, 6, 13, 2, 0, 0, 250000000, time.UTC), true},
+		{"2018-06-13T04:00:00+02:00", time.Date(2018, 6, 13, 2, 0, 0, 0, time.UTC), true},
+		//the time zone of UTC omitted
+		{" 2018-06-13T02:00:00 ", time.Date(2018, 6, 13, 2, 0, 0, 0, time.UTC), true},
+		{"2018-06-13T02:00:00.5", time.Date(2018, 6, 13, 2, 0, 0, 500000000, time.UTC), true},
+		{"2018-06-13", time.Time{}, false},
+		{"", time.Time{}, false},
+	} {
+		dateTime, err := ParseDateTime(test.value)
+		if (err == nil) != test.valid || !dateTime.Equal(test.expected) {
+			t.Errorf("unexpected %s, %v for %q", dateTime, err, test.value)
+		}
+	}
+}
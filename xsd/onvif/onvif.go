@@ -1,6 +1,10 @@
 package onvif
 
 import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+
 	"github.com/use-go/goonvif/xsd"
 )
 
@@ -186,10 +190,78 @@ type StringAttrList struct {
 	AttrList []string
 }
 
+//UnmarshalXMLAttr splits the space separated attribute value
+func (list *StringAttrList) UnmarshalXMLAttr(attr xml.Attr) error {
+	list.AttrList = strings.Fields(attr.Value)
+	return nil
+}
+
+//MarshalXMLAttr joins the list by spaces, an empty list is omitted
+func (list StringAttrList) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if len(list.AttrList) == 0 {
+		return xml.Attr{}, nil
+	}
+	return xml.Attr{Name: name, Value: strings.Join(list.AttrList, " ")}, nil
+}
+
 type IntAttrList struct {
 	IntAttrList []int
 }
 
+//UnmarshalXMLAttr parses the space separated attribute value
+func (list *IntAttrList) UnmarshalXMLAttr(attr xml.Attr) error {
+	list.IntAttrList = nil
+	for _, field := range strings.Fields(attr.Value) {
+		i, err := strconv.Atoi(field)
+		if err != nil {
+			return err
+		}
+		list.IntAttrList = append(list.IntAttrList, i)
+	}
+	return nil
+}
+
+//MarshalXMLAttr joins the list by spaces, an empty list is omitted
+func (list IntAttrList) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if len(list.IntAttrList) == 0 {
+		return xml.Attr{}, nil
+	}
+	fields := make([]string, len(list.IntAttrList))
+	for i, item := range list.IntAttrList {
+		fields[i] = strconv.Itoa(item)
+	}
+	return xml.Attr{Name: name, Value: strings.Join(fields, " ")}, nil
+}
+
+type FloatAttrList struct {
+	FloatAttrList []float64
+}
+
+//UnmarshalXMLAttr parses the space separated attribute value
+func (list *FloatAttrList) UnmarshalXMLAttr(attr xml.Attr) error {
+	list.FloatAttrList = nil
+	for _, field := range strings.Fields(attr.Value) {
+		f, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return err
+		}
+		list.FloatAttrList = append(list.FloatAttrList, f)
+	}
+	return nil
+}
+
+//MarshalXMLAttr joins the list by spaces, an empty list is omitted
+func (list FloatAttrList) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if len(list.FloatAttrList) == 0 {
+		return xml.Attr{}, nil
+	}
+	fields := make([]string, len(list.FloatAttrList))
+	for i, item := range list.FloatAttrList {
+		fields[i] = strconv.FormatFloat(item, 'f', -1, 64)
+	}
+	return xml.Attr{Name: name, Value: strings.Join(fields, " ")}, nil
+}
+
 type DurationRange struct {
 	Min xsd.Duration
 	Max xsd.Duration
@@ -215,7 +287,7 @@ type ReverseOptionsExtension xsd.AnyType
 
 type PTControlDirectionOptionsExtension xsd.AnyType
 type MoveStatus struct {
-	Status string
+	Status string `xml:",chardata"`
 }
 
 type SystemDateTimeExtension xsd.AnyType
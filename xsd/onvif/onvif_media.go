@@ -1,6 +1,8 @@
 package onvif
 
 import (
+	"strings"
+
 	"github.com/use-go/goonvif/xsd"
 )
 
@@ -424,3 +426,81 @@ type ReceiverCapabilities struct {
 	SupportedReceivers   int
 	MaximumRTSPURILength int
 }
+
+//VideoEncoder2Configuration video encoder configuration of the Media2 service,
+//Encoding is a mime name e.g. H264 or H265
+type VideoEncoder2Configuration struct {
+	ConfigurationEntity
+	GovLength   int                    `xml:"GovLength,attr,omitempty"`
+	Profile     xsd.String             `xml:"Profile,attr,omitempty"`
+	Encoding    xsd.String             `xml:"Encoding"`
+	Resolution  VideoResolution2       `xml:"Resolution"`
+	RateControl VideoRateControl2      `xml:"RateControl"`
+	Multicast   MulticastConfiguration `xml:"Multicast"`
+	Quality     xsd.Float              `xml:"Quality"`
+}
+
+type VideoResolution2 struct {
+	Width  xsd.Int `xml:"Width"`
+	Height xsd.Int `xml:"Height"`
+}
+
+type VideoRateControl2 struct {
+	ConstantBitRate xsd.Boolean `xml:"ConstantBitRate,attr"`
+	FrameRateLimit  xsd.Float   `xml:"FrameRateLimit"`
+	BitrateLimit    xsd.Int     `xml:"BitrateLimit"`
+}
+
+type VideoEncoder2ConfigurationOptions struct {
+	GovLengthRange           IntAttrList    `xml:"GovLengthRange,attr"`
+	FrameRatesSupported      FloatAttrList  `xml:"FrameRatesSupported,attr"`
+	ProfilesSupported        StringAttrList `xml:"ProfilesSupported,attr"`
+	ConstantBitRateSupported xsd.Boolean    `xml:"ConstantBitRateSupported,attr"`
+
+	Encoding             xsd.String
+	QualityRange         FloatRange
+	ResolutionsAvailable []VideoResolution2
+	BitrateRange         IntRange
+}
+
+//AudioEncoder2Configuration audio encoder configuration of the Media2 service,
+//Encoding is a mime name e.g. PCMU or MP4A-LATM
+type AudioEncoder2Configuration struct {
+	ConfigurationEntity
+	Encoding   xsd.String             `xml:"Encoding"`
+	Multicast  MulticastConfiguration `xml:"Multicast"`
+	Bitrate    int                    `xml:"Bitrate"`
+	SampleRate int                    `xml:"SampleRate"`
+}
+
+type AudioEncoder2ConfigurationOptions struct {
+	Encoding       xsd.String
+	BitrateList    IntList
+	SampleRateList IntList
+}
+
+type Polygon struct {
+	Point []Vector `xml:"Point"`
+}
+
+//HighestResolution returns the index of the video encoder with the most pixels among <count> encoders
+//described by <encoder>, whose encoding is <encoding>. Encoders without encoding are skipped and an empty
+//<encoding> matches all others, the first one wins a tie. It returns -1 when no encoder matches
+func HighestResolution(count int, encoder func(i int) (encoding string, resolution VideoResolution), encoding string) int {
+	best := -1
+	var bestPixels int64
+	for i := 0; i < count; i++ {
+		encoderEncoding, resolution := encoder(i)
+		if encoderEncoding == "" {
+			continue
+		}
+		if encoding != "" && !strings.EqualFold(encoderEncoding, encoding) {
+			continue
+		}
+		pixels := int64(resolution.Width) * int64(resolution.Height)
+		if best < 0 || pixels > bestPixels {
+			best, bestPixels = i, pixels
+		}
+	}
+	return best
+}
@@ -259,14 +259,14 @@ type PTZNode struct {
 }
 
 type PTZSpaces struct {
-	AbsolutePanTiltPositionSpace    Space2DDescription
-	AbsoluteZoomPositionSpace       Space1DDescription
-	RelativePanTiltTranslationSpace Space2DDescription
-	RelativeZoomTranslationSpace    Space1DDescription
-	ContinuousPanTiltVelocitySpace  Space2DDescription
-	ContinuousZoomVelocitySpace     Space1DDescription
-	PanTiltSpeedSpace               Space1DDescription
-	ZoomSpeedSpace                  Space1DDescription
+	AbsolutePanTiltPositionSpace    []Space2DDescription
+	AbsoluteZoomPositionSpace       []Space1DDescription
+	RelativePanTiltTranslationSpace []Space2DDescription
+	RelativeZoomTranslationSpace    []Space1DDescription
+	ContinuousPanTiltVelocitySpace  []Space2DDescription
+	ContinuousZoomVelocitySpace     []Space1DDescription
+	PanTiltSpeedSpace               []Space1DDescription
+	ZoomSpeedSpace                  []Space1DDescription
 	Extension                       PTZSpacesExtension
 }
 
@@ -305,8 +305,8 @@ type PTZConfigurationOptions2 xsd.AnyType
 //PTZPreset for ptz presets
 type PTZPreset struct {
 	Token       ReferenceToken `xml:"token,attr"`
-	Name        Name         `xml:"Name"`
-	PTZPosition PTZVector    `xml:"PTZPosition"`
+	Name        Name           `xml:"Name"`
+	PTZPosition PTZVector      `xml:"PTZPosition"`
 }
 
 //PTZVector for ptz presets
@@ -323,7 +323,7 @@ type PTZVector2 struct {
 
 //PTZStatus for ptz
 type PTZStatus struct {
-	Position   PTZVector     `xml:"Position"`
+	Position   PTZVector2    `xml:"Position"`
 	MoveStatus PTZMoveStatus `xml:"MoveStatus"`
 	Error      string
 	UtcTime    xsd.DateTime `xml:"UtcTime"`
@@ -342,7 +342,7 @@ type PresetTour struct {
 	Status            PTZPresetTourStatus            `xml:"onvif:Status"`
 	AutoStart         xsd.Boolean                    `xml:"onvif:AutoStart"`
 	StartingCondition PTZPresetTourStartingCondition `xml:"onvif:StartingCondition"`
-	TourSpot          PTZPresetTourSpot              `xml:"onvif:TourSpot"`
+	TourSpot          []PTZPresetTourSpot            `xml:"onvif:TourSpot"`
 	Extension         PTZPresetTourExtension         `xml:"onvif:Extension"`
 }
 
@@ -1,5 +1,69 @@
 package onvif
 
+import (
+	"github.com/use-go/goonvif/xsd"
+)
+
 type RecordingReference ReferenceToken
 
 type RecordingJobRefence ReferenceToken
+
+type TrackReference ReferenceToken
+
+type RecordingJobMode xsd.String
+
+const (
+	RecordingJobModeIdle   = RecordingJobMode("Idle")
+	RecordingJobModeActive = RecordingJobMode("Active")
+)
+
+type TrackType xsd.String
+
+const (
+	TrackTypeVideo    = TrackType("Video")
+	TrackTypeAudio    = TrackType("Audio")
+	TrackTypeMetadata = TrackType("Metadata")
+	TrackTypeExtended = TrackType("Extended")
+)
+
+type RecordingConfiguration struct {
+	Source               RecordingSourceInformation `xml:"tt:Source"`
+	Content              xsd.String                 `xml:"tt:Content"`
+	MaximumRetentionTime xsd.Duration               `xml:"tt:MaximumRetentionTime"`
+}
+
+type RecordingSourceInformation struct {
+	SourceId    xsd.AnyURI `xml:"tt:SourceId"`
+	Name        Name       `xml:"tt:Name"`
+	Location    xsd.String `xml:"tt:Location"`
+	Description xsd.String `xml:"tt:Description"`
+	Address     xsd.AnyURI `xml:"tt:Address"`
+}
+
+type TrackConfiguration struct {
+	TrackType   TrackType  `xml:"tt:TrackType"`
+	Description xsd.String `xml:"tt:Description"`
+}
+
+type RecordingJobConfiguration struct {
+	RecordingToken RecordingReference   `xml:"tt:RecordingToken"`
+	Mode           RecordingJobMode     `xml:"tt:Mode"`
+	Priority       xsd.Int              `xml:"tt:Priority"`
+	Source         []RecordingJobSource `xml:"tt:Source,omitempty"`
+}
+
+type RecordingJobSource struct {
+	SourceToken        *SourceReference    `xml:"tt:SourceToken,omitempty"`
+	AutoCreateReceiver *xsd.Boolean        `xml:"tt:AutoCreateReceiver,omitempty"`
+	Tracks             []RecordingJobTrack `xml:"tt:Tracks,omitempty"`
+}
+
+type RecordingJobTrack struct {
+	SourceTag   xsd.String     `xml:"tt:SourceTag"`
+	Destination TrackReference `xml:"tt:Destination"`
+}
+
+type StorageReferencePath struct {
+	StorageToken ReferenceToken `xml:"tt:StorageToken"`
+	RelativePath xsd.String     `xml:"tt:RelativePath,omitempty"`
+}
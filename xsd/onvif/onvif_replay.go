@@ -0,0 +1,9 @@
+package onvif
+
+import (
+	"github.com/use-go/goonvif/xsd"
+)
+
+type ReplayConfiguration struct {
+	SessionTimeout xsd.Duration `xml:"tt:SessionTimeout"`
+}
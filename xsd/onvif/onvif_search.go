@@ -9,12 +9,13 @@ type JobToken ReferenceToken
 type SearchScopeExtension string
 
 type SearchScope struct {
-	IncludedSources   SourceReference      `xml:"tt:IncludedSources"`
-	IncludeRecordings RecordingReference   `xml:"tt:IncludeRecordings"`
-	Extension         SearchScopeExtension `xml:"tt:Extension"`
+	IncludedSources            []SourceReference    `xml:"tt:IncludedSources,omitempty"`
+	IncludedRecordings         []RecordingReference `xml:"tt:IncludedRecordings,omitempty"`
+	RecordingInformationFilter xsd.String           `xml:"tt:RecordingInformationFilter,omitempty"`
+	Extension                  SearchScopeExtension `xml:"tt:Extension,omitempty"`
 }
 
 type SourceReference struct {
-	Type  xsd.AnyURI     `xml:"tt:Type"`
+	Type  xsd.AnyURI     `xml:"Type,attr,omitempty"`
 	Token ReferenceToken `xml:"tt:Token"`
 }